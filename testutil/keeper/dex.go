@@ -20,6 +20,13 @@ import (
 )
 
 func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := DexKeeperWithStoreKey(t)
+	return k, ctx
+}
+
+// DexKeeperWithStoreKey also returns the store key of the module, for the tests writing the store
+// directly such as the ones of store migrations
+func DexKeeperWithStoreKey(t testing.TB) (*keeper.Keeper, sdk.Context, sdk.StoreKey) {
 	logger := log.NewNopLogger()
 
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
@@ -65,6 +72,7 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		&IBCKeeper.PortKeeper,
 		capabilityKeeper.ScopeToModule("DexScopedKeeper"),
 		nil,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
@@ -72,5 +80,5 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, ctx, storeKey
 }
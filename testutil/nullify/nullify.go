@@ -11,6 +11,8 @@ import (
 var (
 	coinType  = reflect.TypeOf(sdk.Coin{})
 	coinsType = reflect.TypeOf(sdk.Coins{})
+	intType   = reflect.TypeOf(sdk.Int{})
+	decType   = reflect.TypeOf(sdk.Dec{})
 )
 
 // Fill analyze all struct fields and slices with
@@ -45,6 +47,14 @@ func Fill(x interface{}) interface{} {
 					coins := reflect.New(coinsType).Interface()
 					s := reflect.ValueOf(coins).Elem()
 					f.Set(s)
+				case intType:
+					if f.Interface().(sdk.Int).IsNil() {
+						f.Set(reflect.ValueOf(sdk.ZeroInt()))
+					}
+				case decType:
+					if f.Interface().(sdk.Dec).IsNil() {
+						f.Set(reflect.ValueOf(sdk.ZeroDec()))
+					}
 				default:
 					objPt := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Interface()
 					s := Fill(objPt)
@@ -31,6 +31,17 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowBuyOrderBook())
 	cmd.AddCommand(CmdListDenomTrace())
 	cmd.AddCommand(CmdShowDenomTrace())
+	cmd.AddCommand(CmdShowDepth())
+	cmd.AddCommand(CmdListTicker())
+	cmd.AddCommand(CmdShowTicker())
+	cmd.AddCommand(CmdListTrades())
+	cmd.AddCommand(CmdListTradesByAccount())
+	cmd.AddCommand(CmdListCandles())
+	cmd.AddCommand(CmdListMyOrders())
+	cmd.AddCommand(CmdListPendingOrders())
+	cmd.AddCommand(CmdListPendingOrdersByAccount())
+	cmd.AddCommand(CmdListFeeStats())
+	cmd.AddCommand(CmdShowFeeStats())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+const (
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
+)
+
+func CmdListCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-candles [pair-index] [interval]",
+		Short: "list the candles of a pair at an interval of 1m, 1h or 1d",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			argInterval, err := types.ParseCandleInterval(args[1])
+			if err != nil {
+				return err
+			}
+
+			argStartTime, err := cmd.Flags().GetUint64(flagStartTime)
+			if err != nil {
+				return err
+			}
+
+			argEndTime, err := cmd.Flags().GetUint64(flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCandlesRequest{
+				PairIndex:  args[0],
+				Interval:   argInterval,
+				StartTime:  argStartTime,
+				EndTime:    argEndTime,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Candles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartTime, 0, "Earliest open time of the candles in unix nanoseconds")
+	cmd.Flags().Uint64(flagEndTime, 0, "Open time the candles open before in unix nanoseconds, no limit if zero")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
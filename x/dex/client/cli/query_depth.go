@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+const (
+	flagDepth      = "depth"
+	flagBucketSize = "bucket-size"
+)
+
+func CmdShowDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-depth [index]",
+		Short: "shows the aggregated depth of both sides of a pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIndex := args[0]
+
+			argDepth, err := cmd.Flags().GetUint32(flagDepth)
+			if err != nil {
+				return err
+			}
+
+			argBucketSize, err := cmd.Flags().GetString(flagBucketSize)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDepthRequest{
+				Index:      argIndex,
+				Limit:      argDepth,
+				BucketSize: argBucketSize,
+			}
+
+			res, err := queryClient.Depth(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flagDepth, 0, "Maximum number of price levels of each side, all the levels if zero")
+	cmd.Flags().String(flagBucketSize, "", "Size of the price buckets grouping the levels")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+func CmdListFeeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-fee-stats",
+		Short: "list the trading fees collected and distributed in each denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllFeeStatsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FeeStatsAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowFeeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-fee-stats [denom]",
+		Short: "shows the trading fees collected and distributed in a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDenom := args[0]
+
+			params := &types.QueryGetFeeStatsRequest{
+				Denom: argDenom,
+			}
+
+			res, err := queryClient.FeeStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
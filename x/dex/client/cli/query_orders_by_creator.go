@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+func CmdListMyOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-my-orders [address]",
+		Short: "list the orders of an account resting on all the books, the account of --from by default",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var argCreator string
+			if len(args) > 0 {
+				argCreator = args[0]
+			} else {
+				from, err := cmd.Flags().GetString(flags.FlagFrom)
+				if err != nil {
+					return err
+				}
+				fromAddr, _, _, err := client.GetFromFields(clientCtx.Keyring, from, false)
+				if err != nil {
+					return err
+				}
+				if fromAddr.Empty() {
+					return errors.New("an address or the --from flag is required")
+				}
+				argCreator = fromAddr.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryOrdersByCreatorRequest{
+				Creator:    argCreator,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.OrdersByCreator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the account whose orders are listed")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
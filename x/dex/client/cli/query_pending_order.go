@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+func CmdListPendingOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-orders",
+		Short: "list the orders sent from this chain awaiting their acknowledgement or timeout",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingOrdersRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingOrders(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPendingOrdersByAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-orders-by-account [address]",
+		Short: "list the orders of an account sent from this chain awaiting their acknowledgement or timeout",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingOrdersByAccountRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingOrdersByAccount(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	// "github.com/cosmos/cosmos-sdk/client/flags"
 	"interchange-nel/x/dex/types"
 )
@@ -17,6 +18,25 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagMarket                 = "market"
+	flagTimeInForce            = "time-in-force"
+	flagExpiryTimestamp        = "expiry-timestamp"
+	flagExpiryHeight           = "expiry-height"
+	flagPostOnly               = "post-only"
+	flagStopPrice              = "stop-price"
+	flagDisplayAmount          = "display-amount"
+	flagSelfTradePrevention    = "self-trade-prevention"
+	flagMaxQuote               = "max-quote"
+	flagTickSize               = "tick-size"
+	flagLotSize                = "lot-size"
+	flagMinAmount              = "min-amount"
+	flagMaxAmount              = "max-amount"
+	flagMinNotional            = "min-notional"
+	flagBatchAuction           = "batch-auction"
+	flagProRata                = "pro-rata"
+	flagMinAllocation          = "min-allocation"
+	flagMakerFeeRate           = "maker-fee-rate"
+	flagTakerFeeRate           = "taker-fee-rate"
 	listSeparator              = ","
 )
 
@@ -35,7 +55,221 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdSendBuyOrder())
 	cmd.AddCommand(CmdCancelSellOrder())
 	cmd.AddCommand(CmdCancelBuyOrder())
+	cmd.AddCommand(CmdCancelStopOrder())
+	cmd.AddCommand(CmdAmendOrder())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+// parseTimeInForce parses the time in force of an order from its short name
+func parseTimeInForce(s string) (types.TimeInForce, error) {
+	switch s {
+	case "gtc":
+		return types.GoodTilCancelled, nil
+	case "ioc":
+		return types.ImmediateOrCancel, nil
+	case "fok":
+		return types.FillOrKill, nil
+	default:
+		return 0, fmt.Errorf("invalid time in force: %s", s)
+	}
+}
+
+// parseSelfTradePrevention parses the self-trade prevention mode of an order from its short name
+func parseSelfTradePrevention(s string) (types.SelfTradePrevention, error) {
+	switch s {
+	case "cancel-resting":
+		return types.CancelResting, nil
+	case "cancel-incoming":
+		return types.CancelIncoming, nil
+	case "decrement-both":
+		return types.DecrementBoth, nil
+	default:
+		return 0, fmt.Errorf("invalid self-trade prevention: %s", s)
+	}
+}
+
+// parseOrderSide parses the side of an order book from its name
+func parseOrderSide(s string) (types.OrderSide, error) {
+	switch s {
+	case "buy":
+		return types.BuySide, nil
+	case "sell":
+		return types.SellSide, nil
+	default:
+		return 0, fmt.Errorf("invalid side: %s", s)
+	}
+}
+
+// parseOrderExpiry parses the expiry of an order from the command flags
+func parseOrderExpiry(cmd *cobra.Command) (expiry types.OrderExpiry, err error) {
+	expiry.Timestamp, err = cmd.Flags().GetUint64(flagExpiryTimestamp)
+	if err != nil {
+		return expiry, err
+	}
+
+	expiry.Height, err = cmd.Flags().GetInt64(flagExpiryHeight)
+	if err != nil {
+		return expiry, err
+	}
+
+	return expiry, nil
+}
+
+// parseStopPrice parses the stop price of an order from the command flags, an empty stop price is none
+func parseStopPrice(cmd *cobra.Command) (sdk.Dec, error) {
+	argStopPrice, err := cmd.Flags().GetString(flagStopPrice)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if argStopPrice == "" {
+		return sdk.ZeroDec(), nil
+	}
+
+	return sdk.NewDecFromStr(argStopPrice)
+}
+
+// parseDisplayAmount parses the display amount of an iceberg order from the command flags, an empty
+// display amount is none
+func parseDisplayAmount(cmd *cobra.Command) (sdk.Int, error) {
+	argDisplayAmount, err := cmd.Flags().GetString(flagDisplayAmount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if argDisplayAmount == "" {
+		return sdk.ZeroInt(), nil
+	}
+
+	displayAmount, ok := sdk.NewIntFromString(argDisplayAmount)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid display amount: %s", argDisplayAmount)
+	}
+
+	return displayAmount, nil
+}
+
+// parseMaxQuote parses the maximum quote of a market buy order without protection price from the
+// command flags, an empty maximum quote is none
+func parseMaxQuote(cmd *cobra.Command) (sdk.Int, error) {
+	argMaxQuote, err := cmd.Flags().GetString(flagMaxQuote)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if argMaxQuote == "" {
+		return sdk.ZeroInt(), nil
+	}
+
+	maxQuote, ok := sdk.NewIntFromString(argMaxQuote)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid max quote: %s", argMaxQuote)
+	}
+
+	return maxQuote, nil
+}
+
+// addPairRulesFlags adds the flags of the trading rules of a pair to a command
+func addPairRulesFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTickSize, "", "Increment of the order prices of the pair")
+	cmd.Flags().String(flagLotSize, "", "Increment of the order amounts of the pair")
+	cmd.Flags().String(flagMinAmount, "", "Minimum order amount of the pair")
+	cmd.Flags().String(flagMaxAmount, "", "Maximum order amount of the pair")
+	cmd.Flags().String(flagMinNotional, "", "Minimum amount of price denom of the orders of the pair")
+	cmd.Flags().Bool(flagBatchAuction, false, "Match the orders of the pair in batch auctions at the end of each block")
+	cmd.Flags().Bool(flagProRata, false, "Allocate the fills among the orders of the best price level in proportion to their amounts")
+	cmd.Flags().String(flagMinAllocation, "", "Minimum amount allocated to an order by pro-rata matching")
+}
+
+// addPairFeeRatesFlags adds the flags of the fee rates overridden for a pair to a command, only governance
+// can override them
+func addPairFeeRatesFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMakerFeeRate, "", "Maker fee rate of the pair, the one of the module params if empty")
+	cmd.Flags().String(flagTakerFeeRate, "", "Taker fee rate of the pair, the one of the module params if empty")
+}
+
+// parsePairRules parses the trading rules of a pair from the command flags, an empty rule is disabled
+func parsePairRules(cmd *cobra.Command) (rules types.PairRules, err error) {
+	argTickSize, err := cmd.Flags().GetString(flagTickSize)
+	if err != nil {
+		return rules, err
+	}
+	rules.TickSize = sdk.ZeroDec()
+	if argTickSize != "" {
+		rules.TickSize, err = sdk.NewDecFromStr(argTickSize)
+		if err != nil {
+			return rules, err
+		}
+	}
+
+	for _, rule := range []struct {
+		flag  string
+		value *sdk.Int
+	}{
+		{flagLotSize, &rules.LotSize},
+		{flagMinAmount, &rules.MinAmount},
+		{flagMaxAmount, &rules.MaxAmount},
+		{flagMinNotional, &rules.MinNotional},
+		{flagMinAllocation, &rules.MinAllocation},
+	} {
+		arg, err := cmd.Flags().GetString(rule.flag)
+		if err != nil {
+			return rules, err
+		}
+		*rule.value = sdk.ZeroInt()
+		if arg == "" {
+			continue
+		}
+
+		value, ok := sdk.NewIntFromString(arg)
+		if !ok {
+			return rules, fmt.Errorf("invalid %s: %s", rule.flag, arg)
+		}
+		*rule.value = value
+	}
+
+	batchAuction, err := cmd.Flags().GetBool(flagBatchAuction)
+	if err != nil {
+		return rules, err
+	}
+	if batchAuction {
+		rules.MatchingMode = types.BatchAuction
+	}
+
+	proRata, err := cmd.Flags().GetBool(flagProRata)
+	if err != nil {
+		return rules, err
+	}
+	if proRata {
+		rules.MatchingAlgorithm = types.ProRata
+	}
+
+	return rules, nil
+}
+
+// parsePairFeeRates parses the fee rates overridden for a pair from the command flags into its rules, an
+// empty fee rate is the one of the module params
+func parsePairFeeRates(cmd *cobra.Command, rules *types.PairRules) error {
+	for _, rate := range []struct {
+		flag  string
+		value **sdk.Dec
+	}{
+		{flagMakerFeeRate, &rules.MakerFeeRate},
+		{flagTakerFeeRate, &rules.TakerFeeRate},
+	} {
+		arg, err := cmd.Flags().GetString(rate.flag)
+		if err != nil {
+			return err
+		}
+		if arg == "" {
+			continue
+		}
+
+		value, err := sdk.NewDecFromStr(arg)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %s", rate.flag, arg)
+		}
+		*rate.value = &value
+	}
+
+	return nil
+}
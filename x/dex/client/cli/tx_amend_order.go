@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAmendOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-order [port] [channel] [amount-denom] [price-denom] [buy|sell] [order-id] [amount] [price]",
+		Short: "Reduce the amount or change the price of an order",
+		Args:  cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPort := args[0]
+			argChannel := args[1]
+			argAmountDenom := args[2]
+			argPriceDenom := args[3]
+			argSide, err := parseOrderSide(args[4])
+			if err != nil {
+				return err
+			}
+			argOrderID, err := cast.ToInt32E(args[5])
+			if err != nil {
+				return err
+			}
+			argAmount, ok := sdk.NewIntFromString(args[6])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[6])
+			}
+			argPrice, err := sdk.NewDecFromStr(args[7])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAmendOrder(
+				clientCtx.GetFromAddress().String(),
+				argPort,
+				argChannel,
+				argAmountDenom,
+				argPriceDenom,
+				argSide,
+				argOrderID,
+				argAmount,
+				argPrice,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,13 +1,14 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	channelutils "github.com/cosmos/ibc-go/v3/modules/core/04-channel/client/utils"
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"interchange-nel/x/dex/types"
 )
@@ -30,12 +31,64 @@ func CmdSendBuyOrder() *cobra.Command {
 			srcChannel := args[1]
 
 			argAmountDenom := args[2]
-			argAmount, err := cast.ToInt32E(args[3])
+			argAmount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[3])
+			}
+			argPriceDenom := args[4]
+			argPrice, err := sdk.NewDecFromStr(args[5])
 			if err != nil {
 				return err
 			}
-			argPriceDenom := args[4]
-			argPrice, err := cast.ToInt32E(args[5])
+
+			orderType := types.LimitOrder
+			market, err := cmd.Flags().GetBool(flagMarket)
+			if err != nil {
+				return err
+			}
+			if market {
+				orderType = types.MarketOrder
+			}
+
+			argTimeInForce, err := cmd.Flags().GetString(flagTimeInForce)
+			if err != nil {
+				return err
+			}
+			timeInForce, err := parseTimeInForce(argTimeInForce)
+			if err != nil {
+				return err
+			}
+
+			expiry, err := parseOrderExpiry(cmd)
+			if err != nil {
+				return err
+			}
+
+			postOnly, err := cmd.Flags().GetBool(flagPostOnly)
+			if err != nil {
+				return err
+			}
+
+			stopPrice, err := parseStopPrice(cmd)
+			if err != nil {
+				return err
+			}
+
+			displayAmount, err := parseDisplayAmount(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxQuote, err := parseMaxQuote(cmd)
+			if err != nil {
+				return err
+			}
+
+			argSelfTradePrevention, err := cmd.Flags().GetString(flagSelfTradePrevention)
+			if err != nil {
+				return err
+			}
+			selfTradePrevention, err := parseSelfTradePrevention(argSelfTradePrevention)
 			if err != nil {
 				return err
 			}
@@ -53,7 +106,7 @@ func CmdSendBuyOrder() *cobra.Command {
 				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
 			}
 
-			msg := types.NewMsgSendBuyOrder(creator, srcPort, srcChannel, timeoutTimestamp, argAmountDenom, argAmount, argPriceDenom, argPrice)
+			msg := types.NewMsgSendBuyOrder(creator, srcPort, srcChannel, timeoutTimestamp, argAmountDenom, argAmount, argPriceDenom, argPrice, orderType, timeInForce, expiry, postOnly, stopPrice, displayAmount, selfTradePrevention, maxQuote)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -62,6 +115,15 @@ func CmdSendBuyOrder() *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().Bool(flagMarket, false, "Send a market order, the price is the protection price, 0 for none with --max-quote")
+	cmd.Flags().String(flagTimeInForce, "gtc", "Time in force of the order: gtc, ioc or fok")
+	cmd.Flags().Uint64(flagExpiryTimestamp, 0, "Block time in unix nanoseconds the order expires at, 0 for none")
+	cmd.Flags().Int64(flagExpiryHeight, 0, "Block height the order expires at, 0 for none")
+	cmd.Flags().Bool(flagPostOnly, false, "Reject the order instead of matching it on reception")
+	cmd.Flags().String(flagStopPrice, "", "Hold the order until the last trade price crosses this stop price")
+	cmd.Flags().String(flagDisplayAmount, "", "Only show this amount of the order on the book, hiding the rest in reserve")
+	cmd.Flags().String(flagSelfTradePrevention, "cancel-resting", "Handling of own orders matched: cancel-resting, cancel-incoming or decrement-both")
+	cmd.Flags().String(flagMaxQuote, "", "Amount of price denom escrowed and spent at most by a market order without protection price")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -44,7 +44,12 @@ func CmdSendCreatePair() *cobra.Command {
 				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
 			}
 
-			msg := types.NewMsgSendCreatePair(creator, srcPort, srcChannel, timeoutTimestamp, argSourceDenom, argTargetDenom)
+			rules, err := parsePairRules(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendCreatePair(creator, srcPort, srcChannel, timeoutTimestamp, argSourceDenom, argTargetDenom, rules)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -53,6 +58,7 @@ func CmdSendCreatePair() *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	addPairRulesFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
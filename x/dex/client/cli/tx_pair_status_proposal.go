@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+func CmdSubmitHaltPairProposal() *cobra.Command {
+	return cmdSubmitPairStatusProposal(
+		"halt-pair [pair-index]",
+		"Submit a proposal halting the trading of a pair on this chain",
+		func(title, description, pairIndex string) govtypes.Content {
+			return types.NewHaltPairProposal(title, description, pairIndex)
+		},
+	)
+}
+
+func CmdSubmitResumePairProposal() *cobra.Command {
+	return cmdSubmitPairStatusProposal(
+		"resume-pair [pair-index]",
+		"Submit a proposal resuming the trading of a pair halted on this chain",
+		func(title, description, pairIndex string) govtypes.Content {
+			return types.NewResumePairProposal(title, description, pairIndex)
+		},
+	)
+}
+
+func CmdSubmitDelistPairProposal() *cobra.Command {
+	return cmdSubmitPairStatusProposal(
+		"delist-pair [pair-index]",
+		"Submit a proposal delisting a pair on this chain, its resting orders being cancelled and refunded",
+		func(title, description, pairIndex string) govtypes.Content {
+			return types.NewDelistPairProposal(title, description, pairIndex)
+		},
+	)
+}
+
+// cmdSubmitPairStatusProposal returns the command submitting a proposal targeting a pair from its index
+func cmdSubmitPairStatusProposal(
+	use string,
+	short string,
+	newContent func(title, description, pairIndex string) govtypes.Content,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			argDeposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(argDeposit)
+			if err != nil {
+				return err
+			}
+
+			content := newContent(title, description, args[0])
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+
+	return cmd
+}
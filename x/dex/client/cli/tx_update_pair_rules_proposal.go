@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"interchange-nel/x/dex/types"
+)
+
+func CmdSubmitUpdatePairRulesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pair-rules [pair-index]",
+		Short: "Submit a proposal updating the trading rules of a pair on this chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			argDeposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(argDeposit)
+			if err != nil {
+				return err
+			}
+
+			rules, err := parsePairRules(cmd)
+			if err != nil {
+				return err
+			}
+			if err := parsePairFeeRates(cmd, &rules); err != nil {
+				return err
+			}
+
+			content := types.NewUpdatePairRulesProposal(title, description, args[0], rules)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	addPairRulesFlags(cmd)
+	addPairFeeRatesFlags(cmd)
+
+	return cmd
+}
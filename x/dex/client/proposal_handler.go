@@ -0,0 +1,28 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"interchange-nel/x/dex/client/cli"
+)
+
+var (
+	UpdatePairRulesProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitUpdatePairRulesProposal, emptyRestHandler)
+	HaltPairProposalHandler        = govclient.NewProposalHandler(cli.CmdSubmitHaltPairProposal, emptyRestHandler)
+	ResumePairProposalHandler      = govclient.NewProposalHandler(cli.CmdSubmitResumePairProposal, emptyRestHandler)
+	DelistPairProposalHandler      = govclient.NewProposalHandler(cli.CmdSubmitDelistPairProposal, emptyRestHandler)
+)
+
+// emptyRestHandler rejects the legacy REST routes, which are not supported for the dex proposals
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-dex",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for dex proposals")
+		},
+	}
+}
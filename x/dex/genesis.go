@@ -9,18 +9,62 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	// Set all the sellOrderBook
+	// Set all the sellOrderBook and their orders
 	for _, elem := range genState.SellOrderBookList {
 		k.SetSellOrderBook(ctx, elem)
+		if elem.Book != nil {
+			for _, order := range elem.Book.Orders {
+				k.SetOrder(ctx, elem.Index, types.SellSide, *order)
+			}
+		}
 	}
-	// Set all the buyOrderBook
+	// Set all the buyOrderBook and their orders
 	for _, elem := range genState.BuyOrderBookList {
 		k.SetBuyOrderBook(ctx, elem)
+		if elem.Book != nil {
+			for _, order := range elem.Book.Orders {
+				k.SetOrder(ctx, elem.Index, types.BuySide, *order)
+			}
+		}
 	}
 	// Set all the denomTrace
 	for _, elem := range genState.DenomTraceList {
 		k.SetDenomTrace(ctx, elem)
 	}
+	// Set all the orderReserve
+	for _, elem := range genState.OrderReserveList {
+		k.SetOrderReserve(ctx, elem)
+	}
+	// Set all the stopOrder
+	for _, elem := range genState.StopOrderList {
+		k.SetStopOrder(ctx, elem)
+	}
+
+	// Set stopOrder count
+	k.SetStopOrderCount(ctx, genState.StopOrderCount)
+	// Set all the lastPrice
+	for _, elem := range genState.LastPriceList {
+		k.SetLastPrice(ctx, elem)
+	}
+	// Set all the trade
+	for _, elem := range genState.TradeList {
+		k.SetTrade(ctx, elem)
+	}
+
+	// Set trade count
+	k.SetTradeCount(ctx, genState.TradeCount)
+	// Set all the candle
+	for _, elem := range genState.CandleList {
+		k.SetCandle(ctx, elem)
+	}
+	// Set all the pendingOrder
+	for _, elem := range genState.PendingOrderList {
+		k.SetPendingOrder(ctx, elem)
+	}
+	// Set all the feeStats
+	for _, elem := range genState.FeeStatsList {
+		k.SetFeeStats(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -43,8 +87,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.PortId = k.GetPort(ctx)
 	genesis.SellOrderBookList = k.GetAllSellOrderBook(ctx)
+	for _, elem := range genesis.SellOrderBookList {
+		k.LoadOrders(ctx, elem.Index, types.SellSide, elem.Book)
+	}
 	genesis.BuyOrderBookList = k.GetAllBuyOrderBook(ctx)
+	for _, elem := range genesis.BuyOrderBookList {
+		k.LoadOrders(ctx, elem.Index, types.BuySide, elem.Book)
+	}
 	genesis.DenomTraceList = k.GetAllDenomTrace(ctx)
+	genesis.StopOrderList = k.GetAllStopOrder(ctx)
+	genesis.StopOrderCount = k.GetStopOrderCount(ctx)
+	genesis.LastPriceList = k.GetAllLastPrice(ctx)
+	genesis.OrderReserveList = k.GetAllOrderReserve(ctx)
+	genesis.TradeList = k.GetAllTrade(ctx)
+	genesis.TradeCount = k.GetTradeCount(ctx)
+	genesis.CandleList = k.GetAllCandle(ctx)
+	genesis.PendingOrderList = k.GetAllPendingOrder(ctx)
+	genesis.FeeStatsList = k.GetAllFeeStats(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -3,6 +3,7 @@ package dex_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	keepertest "interchange-nel/testutil/keeper"
 	"interchange-nel/testutil/nullify"
@@ -38,6 +39,106 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		StopOrderList: []types.StopOrder{
+			{
+				Id:        0,
+				StopPrice: sdk.NewDec(10),
+				Packet:    &types.StopOrder_SellOrder{SellOrder: &types.SellOrderPacketData{}},
+			},
+			{
+				Id:        1,
+				StopPrice: sdk.NewDec(20),
+				Packet:    &types.StopOrder_BuyOrder{BuyOrder: &types.BuyOrderPacketData{}},
+			},
+		},
+		StopOrderCount: 2,
+		LastPriceList: []types.LastPrice{
+			{
+				PairIndex: "0",
+				Price:     sdk.NewDec(15),
+			},
+			{
+				PairIndex: "1",
+				Price:     sdk.NewDec(15),
+			},
+		},
+		OrderReserveList: []types.OrderReserve{
+			{
+				PairIndex:     "0",
+				Side:          uint32(types.SellSide),
+				Id:            0,
+				DisplayAmount: sdk.NewInt(10),
+				Reserve:       sdk.NewInt(20),
+			},
+			{
+				PairIndex:     "1",
+				Side:          uint32(types.BuySide),
+				Id:            0,
+				DisplayAmount: sdk.NewInt(10),
+				Reserve:       sdk.NewInt(20),
+			},
+		},
+		TradeList: []types.Trade{
+			{
+				Id:        0,
+				PairIndex: "0",
+				Maker:     "0",
+				Taker:     "1",
+				Price:     sdk.NewDec(15),
+				Amount:    sdk.NewInt(10),
+			},
+			{
+				Id:        1,
+				PairIndex: "1",
+				Maker:     "1",
+				Taker:     "0",
+				Price:     sdk.NewDec(15),
+				Amount:    sdk.NewInt(20),
+			},
+		},
+		TradeCount: 2,
+		CandleList: []types.Candle{
+			{
+				PairIndex: "0",
+				Interval:  types.OneMinute,
+				OpenTime:  60,
+				Open:      sdk.NewDec(15),
+			},
+			{
+				PairIndex: "0",
+				Interval:  types.OneHour,
+				OpenTime:  3600,
+				Open:      sdk.NewDec(15),
+			},
+		},
+		PendingOrderList: []types.PendingOrder{
+			{
+				Port:         "dex",
+				Channel:      "channel-0",
+				Sequence:     1,
+				Creator:      "0",
+				Amount:       sdk.NewInt(10),
+				Price:        sdk.NewDec(15),
+				EscrowAmount: sdk.NewInt(10),
+			},
+			{
+				Port:         "dex",
+				Channel:      "channel-0",
+				Sequence:     2,
+				Creator:      "1",
+				Amount:       sdk.NewInt(10),
+				Price:        sdk.NewDec(15),
+				EscrowAmount: sdk.NewInt(150),
+			},
+		},
+		FeeStatsList: []types.FeeStats{
+			{
+				Denom: "foo",
+			},
+			{
+				Denom: "bar",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -54,5 +155,67 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.SellOrderBookList, got.SellOrderBookList)
 	require.ElementsMatch(t, genesisState.BuyOrderBookList, got.BuyOrderBookList)
 	require.ElementsMatch(t, genesisState.DenomTraceList, got.DenomTraceList)
+	require.ElementsMatch(t, genesisState.StopOrderList, got.StopOrderList)
+	require.Equal(t, genesisState.StopOrderCount, got.StopOrderCount)
+	require.ElementsMatch(t, genesisState.LastPriceList, got.LastPriceList)
+	require.ElementsMatch(t, genesisState.OrderReserveList, got.OrderReserveList)
+	require.ElementsMatch(t, genesisState.TradeList, got.TradeList)
+	require.Equal(t, genesisState.TradeCount, got.TradeCount)
+	require.ElementsMatch(t, genesisState.CandleList, got.CandleList)
+	require.ElementsMatch(t, genesisState.PendingOrderList, got.PendingOrderList)
+	require.ElementsMatch(t, genesisState.FeeStatsList, got.FeeStatsList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisOrders(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		PortId: types.PortID,
+		SellOrderBookList: []types.SellOrderBook{
+			{
+				Index: "0",
+				Rules: types.NewPairRules(sdk.OneDec(), sdk.NewInt(10), sdk.NewInt(10), sdk.NewInt(1000), sdk.NewInt(50)),
+				Book: &types.OrderBook{
+					IdCount: 2,
+					Orders: []*types.Order{
+						{Id: 1, Creator: "1", Amount: sdk.NewInt(20), Price: sdk.NewDec(15)},
+						{Id: 0, Creator: "0", Amount: sdk.NewInt(10), Price: sdk.NewDec(20)},
+					},
+				},
+			},
+		},
+		BuyOrderBookList: []types.BuyOrderBook{
+			{
+				Index: "0",
+				Rules: types.NewPairRules(sdk.OneDec(), sdk.NewInt(10), sdk.NewInt(10), sdk.NewInt(1000), sdk.NewInt(50)),
+				Book: &types.OrderBook{
+					IdCount: 3,
+					Orders: []*types.Order{
+						{Id: 2, Creator: "2", Amount: sdk.NewInt(30), Price: sdk.NewDec(10)},
+						{Id: 0, Creator: "0", Amount: sdk.NewInt(10), Price: sdk.NewDec(5)},
+					},
+				},
+			},
+		},
+	}
+
+	k, ctx := keepertest.DexKeeper(t)
+	dex.InitGenesis(ctx, *k, genesisState)
+
+	// orders are imported into the order store
+	order, found := k.GetOrder(ctx, "0", types.BuySide, sdk.NewDec(10), 2)
+	require.True(t, found)
+	require.Equal(t, *genesisState.BuyOrderBookList[0].Book.Orders[0], order)
+
+	// and indexed by ID and creator
+	order, err := k.GetOrderFromID(ctx, "0", types.SellSide, 1)
+	require.NoError(t, err)
+	require.Equal(t, *genesisState.SellOrderBookList[0].Book.Orders[0], order)
+	require.Len(t, k.GetAllOrderLocationByCreator(ctx, "0"), 2)
+
+	got := dex.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+
+	require.Equal(t, genesisState.SellOrderBookList, got.SellOrderBookList)
+	require.Equal(t, genesisState.BuyOrderBookList, got.BuyOrderBookList)
+}
@@ -32,6 +32,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCancelBuyOrder:
 			res, err := msgServer.CancelBuyOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelStopOrder:
+			res, err := msgServer.CancelStopOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAmendOrder:
+			res, err := msgServer.AmendOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
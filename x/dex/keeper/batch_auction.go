@@ -0,0 +1,479 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// GetAuctionOrderCount get the total number of auctionOrder
+func (k Keeper) GetAuctionOrderCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.AuctionOrderCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetAuctionOrderCount set the total number of auctionOrder
+func (k Keeper) SetAuctionOrderCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.AuctionOrderCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendAuctionOrder queues an order packet for the batch auction of its pair with a new id and update
+// the count
+func (k Keeper) AppendAuctionOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	packet channeltypes.Packet,
+) uint64 {
+	count := k.GetAuctionOrderCount(ctx)
+
+	auctionOrder := types.AuctionOrder{
+		Id:        count,
+		PairIndex: pairIndex,
+		Packet:    packet,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionOrderKey))
+	store.Set(types.AuctionOrderIDKey(auctionOrder.Id), k.cdc.MustMarshal(&auctionOrder))
+
+	k.SetAuctionOrderCount(ctx, count+1)
+
+	return count
+}
+
+// RemoveAuctionOrder removes an auctionOrder from the store
+func (k Keeper) RemoveAuctionOrder(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionOrderKey))
+	store.Delete(types.AuctionOrderIDKey(id))
+}
+
+// GetAllAuctionOrder returns all auctionOrder in the order they were queued
+func (k Keeper) GetAllAuctionOrder(ctx sdk.Context) (list []types.AuctionOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionOrderKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuctionOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// auctionOrder is an order of a batch auction with its allocation
+type auctionOrder struct {
+	pairIndex string
+	packet    channeltypes.Packet
+	sellOrder *types.SellOrderPacketData
+	buyOrder  *types.BuyOrderPacketData
+
+	// order is the incoming order left to match after self-trade prevention
+	order               types.Order
+	selfTradePrevention types.SelfTradePrevention
+	selfTrade           types.SelfTrade
+	filled              sdk.Int
+	quote               sdk.Int
+	fills               []types.Order
+	makerFee            sdk.Int
+	takerFee            sdk.Int
+}
+
+// newAuctionOrder decodes an order queued for a batch auction
+func newAuctionOrder(queued types.AuctionOrder) (*auctionOrder, error) {
+	var modulePacketData types.DexPacketData
+	if err := modulePacketData.Unmarshal(queued.Packet.GetData()); err != nil {
+		return nil, err
+	}
+
+	o := &auctionOrder{
+		pairIndex: queued.PairIndex,
+		packet:    queued.Packet,
+		selfTrade: types.NewSelfTrade(),
+		filled:    sdk.ZeroInt(),
+		quote:     sdk.ZeroInt(),
+		makerFee:  sdk.ZeroInt(),
+		takerFee:  sdk.ZeroInt(),
+	}
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.DexPacketData_SellOrderPacket:
+		o.sellOrder = packet.SellOrderPacket
+		o.order = types.Order{Creator: o.sellOrder.Seller, Amount: o.sellOrder.Amount, Price: o.sellOrder.Price}
+		o.selfTradePrevention = o.sellOrder.SelfTradePrevention
+	case *types.DexPacketData_BuyOrderPacket:
+		o.buyOrder = packet.BuyOrderPacket
+		o.order = types.Order{Creator: o.buyOrder.Buyer, Amount: o.buyOrder.Amount, Price: o.buyOrder.Price}
+		o.selfTradePrevention = o.buyOrder.SelfTradePrevention
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized auction order packet type: %T", packet)
+	}
+
+	return o, nil
+}
+
+// restingSide returns the side of the book the order is matched against
+func (o auctionOrder) restingSide() types.OrderSide {
+	if o.sellOrder != nil {
+		return types.BuySide
+	}
+
+	return types.SellSide
+}
+
+// denoms returns the amount and price denoms of the pair of the order
+func (o auctionOrder) denoms() (amountDenom string, priceDenom string) {
+	if o.sellOrder != nil {
+		return o.sellOrder.AmountDenom, o.sellOrder.PriceDenom
+	}
+
+	return o.buyOrder.AmountDenom, o.buyOrder.PriceDenom
+}
+
+// ack returns the acknowledgement of the order once the auction cleared, the same as the one of a
+// continuously matched order
+func (o auctionOrder) ack(ctx sdk.Context) channeltypes.Acknowledgement {
+	remaining := o.order.Amount.Sub(o.filled)
+
+	var packetAckBytes []byte
+	var err error
+	if o.sellOrder != nil {
+		packetAckBytes, err = types.ModuleCdc.MarshalJSON(&types.SellOrderPacketAck{
+			RemainingAmount: remaining,
+			Gain:            o.quote,
+			CancelledAmount: o.selfTrade.Incoming,
+			Fills:           o.fills,
+			Height:          ctx.BlockHeight(),
+			Timestamp:       uint64(ctx.BlockTime().UnixNano()),
+			MakerFee:        o.makerFee,
+			TakerFee:        o.takerFee,
+		})
+	} else {
+		packetAckBytes, err = types.ModuleCdc.MarshalJSON(&types.BuyOrderPacketAck{
+			RemainingAmount: remaining,
+			Purchase:        o.filled,
+			Quote:           o.quote,
+			CancelledAmount: o.selfTrade.Incoming,
+			Fills:           o.fills,
+			Height:          ctx.BlockHeight(),
+			Timestamp:       uint64(ctx.BlockTime().UnixNano()),
+			MakerFee:        o.makerFee,
+			TakerFee:        o.takerFee,
+		})
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
+	}
+
+	return channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+}
+
+// ClearBatchAuctions clears the batch auctions of the pairs with queued orders and writes the
+// acknowledgements of their packets. The queue is emptied at the end of every block and is therefore
+// not part of the genesis state
+func (k Keeper) ClearBatchAuctions(ctx sdk.Context) {
+	// group the queued orders by pair and side, in the order the pairs were first queued
+	var auctionKeys []string
+	auctions := make(map[string][]*auctionOrder)
+	for _, queued := range k.GetAllAuctionOrder(ctx) {
+		k.RemoveAuctionOrder(ctx, queued.Id)
+
+		o, err := newAuctionOrder(queued)
+		if err != nil {
+			k.writeAuctionAck(ctx, queued.Packet, channeltypes.NewErrorAcknowledgement(err.Error()))
+			continue
+		}
+
+		auctionKey := string(types.OrderBookSideKey(o.pairIndex, o.restingSide()))
+		if _, ok := auctions[auctionKey]; !ok {
+			auctionKeys = append(auctionKeys, auctionKey)
+		}
+		auctions[auctionKey] = append(auctions[auctionKey], o)
+	}
+
+	for _, auctionKey := range auctionKeys {
+		orders := auctions[auctionKey]
+
+		// a failing auction is discarded and all its orders are refunded on their source chain
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.clearBatchAuction(cacheCtx, orders); err != nil {
+			for _, o := range orders {
+				k.writeAuctionAck(ctx, o.packet, channeltypes.NewErrorAcknowledgement(err.Error()))
+			}
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		for _, o := range orders {
+			k.writeAuctionAck(ctx, o.packet, o.ack(ctx))
+		}
+	}
+}
+
+// clearBatchAuction fills the crossing orders of a batch auction against the book at a single clearing
+// price
+func (k Keeper) clearBatchAuction(ctx sdk.Context, orders []*auctionOrder) error {
+	pairIndex, restingSide := orders[0].pairIndex, orders[0].restingSide()
+
+	// the orders queued before the pair was halted or delisted in the block are rejected
+	if !k.isPairTradable(ctx, pairIndex, restingSide) {
+		return types.ErrPairHalted
+	}
+
+	// the resting orders of the same creator are handled before the auction
+	for _, o := range orders {
+		o.order = k.preventSelfTrades(ctx, pairIndex, restingSide, o.order, o.selfTradePrevention, &o.selfTrade)
+	}
+
+	price, volume := types.ClearingPrice(k.auctionLevels(ctx, pairIndex, restingSide, orders))
+	if volume.IsPositive() {
+		if err := k.executeBatchAuction(ctx, pairIndex, restingSide, orders, price, volume); err != nil {
+			return err
+		}
+	}
+
+	// refund the resting orders cancelled by self-trade prevention
+	for _, o := range orders {
+		amountDenom, priceDenom := o.denoms()
+		if err := k.refundSelfTrade(
+			ctx,
+			o.packet.DestinationPort,
+			o.packet.DestinationChannel,
+			amountDenom,
+			priceDenom,
+			restingSide,
+			o.selfTrade,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// auctionLevels returns the bids and asks of a batch auction: the queued orders and the resting orders
+// they may cross, with the hidden reserve of iceberg orders
+func (k Keeper) auctionLevels(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	orders []*auctionOrder,
+) (bids []types.AuctionLevel, asks []types.AuctionLevel) {
+	var queued []types.AuctionLevel
+	var aggressive *types.Order
+	for _, o := range orders {
+		if !o.order.Amount.IsPositive() {
+			continue
+		}
+		queued = append(queued, types.AuctionLevel{Price: o.order.Price, Amount: o.order.Amount})
+
+		if aggressive == nil || crosses(restingSide, o.order, *aggressive) {
+			order := o.order
+			aggressive = &order
+		}
+	}
+	if aggressive == nil {
+		return nil, nil
+	}
+
+	// the resting orders are only needed as long as the most aggressive queued order crosses them
+	var resting []types.AuctionLevel
+	iterator := k.OrderIterator(ctx, pairIndex, restingSide)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		if !crosses(restingSide, *aggressive, order) {
+			break
+		}
+		if isExpired(ctx, order) {
+			continue
+		}
+
+		amount := order.Amount.Add(k.orderReserveAmount(ctx, pairIndex, restingSide, order.Id))
+		resting = append(resting, types.AuctionLevel{Price: order.Price, Amount: amount})
+	}
+
+	if restingSide == types.BuySide {
+		return resting, queued
+	}
+
+	return queued, resting
+}
+
+// executeBatchAuction fills the volume of a batch auction at its clearing price. The queued orders are
+// filled by price priority then in the order they were received, the resting orders by price-time
+// priority, each match being settled on its own so the quotes paid and received are equal
+func (k Keeper) executeBatchAuction(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	orders []*auctionOrder,
+	price sdk.Dec,
+	volume sdk.Int,
+) error {
+	clearing := types.Order{Price: price}
+	var queue []*auctionOrder
+	for _, o := range orders {
+		if o.order.Amount.IsPositive() && crosses(restingSide, o.order, clearing) {
+			queue = append(queue, o)
+		}
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		if restingSide == types.BuySide {
+			return queue[i].order.Price.LT(queue[j].order.Price)
+		}
+		return queue[i].order.Price.GT(queue[j].order.Price)
+	})
+
+	makerFeeRate, takerFeeRate := k.FeeRates(ctx, k.pairRules(ctx, pairIndex, restingSide))
+
+	var liquidated []types.Order
+	left := volume
+	for _, o := range queue {
+		for left.IsPositive() && o.filled.LT(o.order.Amount) {
+			resting, found := k.GetBestOrder(ctx, pairIndex, restingSide)
+			if !found || !crosses(restingSide, resting, clearing) {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "batch auction volume exceeds the resting orders")
+			}
+
+			match := sdk.MinInt(sdk.MinInt(o.order.Amount.Sub(o.filled), resting.Amount), left)
+			quote := types.QuoteAmount(match, price)
+			makerFee, err := k.settleAuctionMatch(ctx, o, resting, match, quote, makerFeeRate)
+			if err != nil {
+				return err
+			}
+			o.makerFee = o.makerFee.Add(makerFee)
+			o.filled = o.filled.Add(match)
+			o.quote = o.quote.Add(quote)
+			left = left.Sub(match)
+
+			resting.Amount = resting.Amount.Sub(match)
+			if resting.Amount.IsZero() {
+				k.RemoveOrder(ctx, pairIndex, restingSide, resting.Price, resting.TimePriority())
+				k.refillIcebergOrder(ctx, pairIndex, restingSide, resting)
+			} else {
+				k.SetOrder(ctx, pairIndex, restingSide, resting)
+			}
+
+			fill := types.Order{
+				Id:      resting.Id,
+				Creator: resting.Creator,
+				Amount:  match,
+				Price:   price,
+			}
+			o.fills = append(o.fills, fill)
+			liquidated = append(liquidated, fill)
+		}
+	}
+
+	// the clearing price is the last price of the pair, the liquidations update its candles
+	k.recordLastPrice(ctx, pairIndex, liquidated)
+	k.updateCandles(ctx, pairIndex, liquidated)
+
+	for _, o := range queue {
+		// the taker fee is deducted from the proceeds of the queued order on its source chain
+		if o.sellOrder != nil {
+			o.takerFee = types.FeeAmount(o.quote, takerFeeRate)
+		} else {
+			o.takerFee = types.FeeAmount(o.filled, takerFeeRate)
+		}
+
+		k.RecordTrades(
+			ctx,
+			pairIndex,
+			o.order.Creator,
+			o.packet.Sequence,
+			ctx.BlockHeight(),
+			uint64(ctx.BlockTime().UnixNano()),
+			o.fills,
+		)
+	}
+
+	return nil
+}
+
+// settleAuctionMatch pays the creator of a resting order matched in a batch auction and returns the maker
+// fee deducted. Bidders receive the amount bought and the part of their escrow above the clearing price,
+// sellers the quote at the clearing price. The queued order is settled on its source chain with the
+// acknowledgement
+func (k Keeper) settleAuctionMatch(
+	ctx sdk.Context,
+	o *auctionOrder,
+	resting types.Order,
+	match sdk.Int,
+	quote sdk.Int,
+	makerFeeRate sdk.Dec,
+) (sdk.Int, error) {
+	addr, err := sdk.AccAddressFromBech32(resting.Creator)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	amountDenom, priceDenom := o.denoms()
+	port, channel := o.packet.DestinationPort, o.packet.DestinationChannel
+
+	if o.restingSide() == types.SellSide {
+		finalPriceDenom, saved := k.OriginalDenom(ctx, port, channel, priceDenom)
+		if !saved {
+			finalPriceDenom = VoucherDenom(o.packet.SourcePort, o.packet.SourceChannel, priceDenom)
+		}
+
+		return k.payMaker(ctx, port, channel, o.pairIndex, makerFeeRate, resting.Creator, finalPriceDenom, quote)
+	}
+
+	finalAmountDenom, saved := k.OriginalDenom(ctx, port, channel, amountDenom)
+	if !saved {
+		finalAmountDenom = VoucherDenom(o.packet.SourcePort, o.packet.SourceChannel, amountDenom)
+	}
+	fee, err := k.payMaker(ctx, port, channel, o.pairIndex, makerFeeRate, resting.Creator, finalAmountDenom, match)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// the bid escrowed its quote at its own price
+	improvement := types.QuoteAmount(match, resting.Price).Sub(quote)
+	if improvement.IsPositive() {
+		if err := k.SafeMint(ctx, port, channel, addr, priceDenom, improvement); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	return fee, nil
+}
+
+// writeAuctionAck writes the acknowledgement of an order packet queued for a batch auction
+func (k Keeper) writeAuctionAck(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	channelCap, ok := k.ScopedKeeper.GetCapability(
+		ctx,
+		host.ChannelCapabilityPath(packet.DestinationPort, packet.DestinationChannel),
+	)
+	if !ok {
+		k.Logger(ctx).Error("module does not own channel capability", "channel", packet.DestinationChannel)
+		return
+	}
+
+	if err := k.channelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ack); err != nil {
+		k.Logger(ctx).Error("cannot write batch auction acknowledgement", "sequence", packet.Sequence, "error", err)
+	}
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func buyAuctionPacket(t *testing.T, sequence uint64, buyer string, amount int64, price int64) channeltypes.Packet {
+	packetData := types.BuyOrderPacketData{
+		AmountDenom:         "foo",
+		Amount:              sdk.NewInt(amount),
+		PriceDenom:          "bar",
+		Price:               sdk.NewDec(price),
+		Buyer:               buyer,
+		SelfTradePrevention: types.CancelIncoming,
+	}
+	packetBytes, err := packetData.GetBytes()
+	require.NoError(t, err)
+
+	return channeltypes.Packet{
+		Data:               packetBytes,
+		Sequence:           sequence,
+		SourcePort:         "dex",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "dex",
+		DestinationChannel: "channel-1",
+	}
+}
+
+func TestAuctionOrderQueue(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	packets := []channeltypes.Packet{
+		buyAuctionPacket(t, 1, MockAccount("0"), 10, 20),
+		buyAuctionPacket(t, 2, MockAccount("1"), 20, 10),
+	}
+	for i, packet := range packets {
+		require.Equal(t, uint64(i), k.AppendAuctionOrder(ctx, testPairIndex, packet))
+	}
+	require.Equal(t, uint64(2), k.GetAuctionOrderCount(ctx))
+
+	// the orders are kept in the order they were queued
+	list := k.GetAllAuctionOrder(ctx)
+	require.Len(t, list, 2)
+	for i, auctionOrder := range list {
+		require.Equal(t, uint64(i), auctionOrder.Id)
+		require.Equal(t, testPairIndex, auctionOrder.PairIndex)
+		require.Equal(t, packets[i], auctionOrder.Packet)
+	}
+
+	k.RemoveAuctionOrder(ctx, 0)
+	list = k.GetAllAuctionOrder(ctx)
+	require.Len(t, list, 1)
+	require.Equal(t, uint64(1), list[0].Id)
+}
+
+func TestClearBatchAuctionsNoCross(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	book := types.NewSellOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	k.SetSellOrderBook(ctx, book)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// the bid below the asks and the bid crossing only an ask of its buyer leave the book untouched
+	k.AppendAuctionOrder(ctx, testPairIndex, buyAuctionPacket(t, 1, MockAccount("3"), 10, 12))
+	k.AppendAuctionOrder(ctx, testPairIndex, buyAuctionPacket(t, 2, MockAccount("2"), 10, 15))
+	k.AppendAuctionOrder(ctx, testPairIndex, channeltypes.Packet{Data: []byte("invalid"), Sequence: 3})
+
+	k.ClearBatchAuctions(ctx)
+	require.Empty(t, k.GetAllAuctionOrder(ctx))
+	require.Equal(t, selfTradeSellBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+
+	_, found := k.GetLastPrice(ctx, testPairIndex)
+	require.False(t, found)
+	require.Empty(t, k.GetAllTrade(ctx))
+}
+
+func TestClearBatchAuctionsHaltedPair(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	book := types.NewSellOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	k.SetSellOrderBook(ctx, book)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// the orders queued before the pair is halted are rejected instead of crossing the book
+	k.AppendAuctionOrder(ctx, testPairIndex, buyAuctionPacket(t, 1, MockAccount("3"), 10, 20))
+	require.NoError(t, k.SetPairHalted(ctx, testPairIndex, true))
+
+	k.ClearBatchAuctions(ctx)
+	require.Empty(t, k.GetAllAuctionOrder(ctx))
+	require.Equal(t, selfTradeSellBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+	require.Empty(t, k.GetAllTrade(ctx))
+}
@@ -76,6 +76,18 @@ func (k Keeper) TransmitBuyOrderPacket(
 		return err
 	}
 
+	// the escrowed funds are tracked until the packet is acknowledged or times out
+	k.recordPendingOrder(ctx, packet, types.PendingOrder{
+		Creator:      packetData.Buyer,
+		Side:         uint32(types.BuySide),
+		AmountDenom:  packetData.AmountDenom,
+		Amount:       packetData.Amount,
+		PriceDenom:   packetData.PriceDenom,
+		Price:        packetData.Price,
+		EscrowDenom:  packetData.PriceDenom,
+		EscrowAmount: packetData.EscrowAmount(),
+	})
+
 	return nil
 }
 
@@ -84,10 +96,10 @@ func (k Keeper) OnRecvBuyOrderPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data types.BuyOrderPacketData,
-) (packetAck types.BuyOrderPacketAck, err error) {
+) (packetAck types.BuyOrderPacketAck, queued bool, err error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
-		return packetAck, err
+		return packetAck, false, err
 	}
 
 	// check if the sell order book exists
@@ -99,18 +111,90 @@ func (k Keeper) OnRecvBuyOrderPacket(
 	)
 	book, found := k.GetSellOrderBook(ctx, pairIndex)
 	if !found {
-		return packetAck, errors.New("The pair doesn't exist")
+		return packetAck, false, errors.New("The pair doesn't exist")
+	}
+	if book.Halted {
+		return packetAck, false, types.ErrPairHalted
+	}
+
+	// check the order against the trading rules of the pair on this chain
+	if err := data.ValidateRules(book.Rules); err != nil {
+		return packetAck, false, err
+	}
+
+	// check the order against the maximum amount and price of the module
+	if err := data.ValidateLimits(k.GetParams(ctx)); err != nil {
+		return packetAck, false, err
+	}
+
+	// the creator identifies the orders of the same trader for self-trade prevention
+	order := types.Order{
+		Creator: data.Buyer,
+		Amount:  data.Amount,
+		Price:   data.Price,
+	}
+
+	// post-only orders are rejected if they would take liquidity
+	if data.PostOnly && k.MatchesBuyOrder(ctx, pairIndex, order) {
+		return packetAck, false, types.ErrPostOnlyWouldMatch
+	}
+
+	// the orders of batch auction pairs are queued until the auction clears at the end of the block and
+	// acknowledged then, post-only orders not crossing the book are acknowledged as they rest
+	if book.Rules.MatchingMode == types.BatchAuction && !data.PostOnly {
+		if data.TimeInForce == types.FillOrKill {
+			return packetAck, false, types.ErrBatchAuctionFillOrKill
+		}
+		if !data.HasProtectionPrice() {
+			return packetAck, false, types.ErrBatchAuctionMaxQuote
+		}
+		k.AppendAuctionOrder(ctx, pairIndex, packet)
+
+		return packetAck, true, nil
+	}
+
+	// market orders without protection price buy the amount their maximum quote affords, up to the
+	// highest ask it reaches
+	if !data.HasProtectionPrice() {
+		order = k.AffordableBuyOrder(ctx, pairIndex, order, data.MaxQuote)
+		if !order.Amount.IsPositive() {
+			return packetAck, false, types.ErrMaxQuoteTooLow
+		}
+	}
+
+	// fill-or-kill orders are rejected unless they can be entirely filled
+	if data.TimeInForce == types.FillOrKill &&
+		(!order.Amount.Equal(data.Amount) || !k.CanFillBuyOrder(ctx, pairIndex, order, data.SelfTradePrevention)) {
+		return packetAck, false, types.ErrFillOrKill
 	}
 
 	// fill buy order
-	remaining, liquidated, purchase, _ := book.FillBuyOrder(types.Order{
-		Amount: data.Amount,
-		Price:  data.Price,
-	})
+	remaining, liquidated, purchase, selfTrade, _ := k.FillBuyOrder(ctx, pairIndex, order, data.SelfTradePrevention)
 
-	// return remaining amount and gains
-	packetAck.RemainingAmount = remaining.Amount
+	// return remaining amount, including the one the maximum quote does not afford, gains and the amount
+	// cancelled by self-trade prevention
+	packetAck.RemainingAmount = remaining.Amount.Add(data.Amount.Sub(order.Amount))
 	packetAck.Purchase = purchase
+	packetAck.CancelledAmount = selfTrade.Incoming
+
+	// record the fills as trades, the source chain records them from the acknowledgement
+	packetAck.Fills = liquidated
+	packetAck.Height = ctx.BlockHeight()
+	packetAck.Timestamp = uint64(ctx.BlockTime().UnixNano())
+	k.RecordTrades(ctx, pairIndex, data.Buyer, packet.Sequence, packetAck.Height, packetAck.Timestamp, liquidated)
+
+	// refund the asks cancelled by self-trade prevention
+	if err := k.refundSelfTrade(
+		ctx,
+		packet.DestinationPort,
+		packet.DestinationChannel,
+		data.AmountDenom,
+		data.PriceDenom,
+		types.SellSide,
+		selfTrade,
+	); err != nil {
+		return packetAck, false, err
+	}
 
 	// before distributing gains, we resolve the denom
 	// first we check if the denom received comes from this chain originally
@@ -125,30 +209,35 @@ func (k Keeper) OnRecvBuyOrderPacket(
 		finalPriceDenom = VoucherDenom(packet.SourcePort, packet.SourceChannel, data.PriceDenom)
 	}
 
-	// dispatch liquidated buy order
+	// dispatch liquidated sell orders minus the maker fee, the taker fee is deducted from the purchase on
+	// the source chain
+	makerFeeRate, takerFeeRate := k.FeeRates(ctx, book.Rules)
+	packetAck.Quote = sdk.ZeroInt()
+	packetAck.MakerFee = sdk.ZeroInt()
+	packetAck.TakerFee = types.FeeAmount(purchase, takerFeeRate)
 	for _, liquidation := range liquidated {
-		liquidation := liquidation
-		addr, err := sdk.AccAddressFromBech32(liquidation.Creator)
-		if err != nil {
-			return packetAck, err
-		}
-
-		if err := k.SafeMint(
+		// the seller is paid at their ask price, which may be lower than the buyer's limit price
+		quote := types.QuoteAmount(liquidation.Amount, liquidation.Price)
+		fee, err := k.payMaker(
 			ctx,
 			packet.DestinationPort,
 			packet.DestinationChannel,
-			addr,
+			pairIndex,
+			makerFeeRate,
+			liquidation.Creator,
 			finalPriceDenom,
-			liquidation.Amount*liquidation.Price,
-		); err != nil {
-			return packetAck, err
+			quote,
+		)
+		if err != nil {
+			return packetAck, false, err
 		}
-	}
+		packetAck.MakerFee = packetAck.MakerFee.Add(fee)
 
-	// save the new order book
-	k.SetSellOrderBook(ctx, book)
+		// report the quote actually spent so the buyer can be refunded the difference
+		packetAck.Quote = packetAck.Quote.Add(quote)
+	}
 
-	return packetAck, nil
+	return packetAck, false, nil
 }
 
 // OnAcknowledgementBuyOrderPacket responds to the the success or failure of a packet
@@ -159,6 +248,9 @@ func (k Keeper) OnAcknowledgementBuyOrderPacket(
 	data types.BuyOrderPacketData,
 	ack channeltypes.Acknowledgement,
 ) error {
+	// the order is no longer in flight
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		// in case of error we mint back the native token
@@ -173,7 +265,7 @@ func (k Keeper) OnAcknowledgementBuyOrderPacket(
 			packet.SourceChannel,
 			receiver,
 			data.PriceDenom,
-			data.Amount*data.Price,
+			data.EscrowAmount(),
 		); err != nil {
 			return err
 		}
@@ -190,28 +282,70 @@ func (k Keeper) OnAcknowledgementBuyOrderPacket(
 
 		// get the sell order book
 		pairIndex := types.OrderBookIndex(packet.SourcePort, packet.SourceChannel, data.AmountDenom, data.PriceDenom)
+		// the pair may have been halted or delisted while the order was in flight
 		book, found := k.GetBuyOrderBook(ctx, pairIndex)
-		if !found {
-			panic("buy order book must exist")
+
+		// record the fills executed on the counterparty chain as trades
+		k.RecordTrades(
+			ctx,
+			pairIndex,
+			data.Buyer,
+			packet.Sequence,
+			packetAck.Height,
+			packetAck.Timestamp,
+			packetAck.Fills,
+		)
+
+		// refund the price improvement, which includes the quote of the amount cancelled by self-trade
+		// prevention
+		refund := data.PriceImprovement(packetAck)
+
+		// append the remaining amount of orders resting on the book and refund the one of the others, the
+		// one left outside the limits of the pair or of the params or without quote amount and the one of
+		// halted and delisted pairs
+		if packetAck.RemainingAmount.IsPositive() {
+			if !found || book.Halted || !data.RestsOnBook() ||
+				book.Rules.ValidateOrder(packetAck.RemainingAmount, data.Price) != nil ||
+				k.GetParams(ctx).ValidateOrder(packetAck.RemainingAmount, data.Price) != nil ||
+				types.ValidateQuote(packetAck.RemainingAmount, data.Price) != nil {
+				refund = refund.Add(types.QuoteAmount(packetAck.RemainingAmount, data.Price))
+			} else {
+				_, err := k.AppendBuyOrder(
+					ctx,
+					book,
+					data.Buyer,
+					packetAck.RemainingAmount,
+					data.Price,
+					data.Expiry,
+					data.DisplayAmount,
+				)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
-		// append the remaining amount of the order
-		if packetAck.RemainingAmount > 0 {
-			_, err := book.AppendOrder(
-				data.Buyer,
-				packetAck.RemainingAmount,
-				data.Price,
-			)
+		if refund.IsPositive() {
+			receiver, err := sdk.AccAddressFromBech32(data.Buyer)
 			if err != nil {
 				return err
 			}
 
-			// save the new order book
-			k.SetBuyOrderBook(ctx, book)
+			if err := k.SafeMint(
+				ctx,
+				packet.SourcePort,
+				packet.SourceChannel,
+				receiver,
+				data.PriceDenom,
+				refund,
+			); err != nil {
+				return err
+			}
 		}
 
-		// mint the purchase
-		if packetAck.Purchase > 0 {
+		// mint the purchase minus the taker fee
+		purchase, takerFee := packetAck.NetProceeds()
+		if packetAck.Purchase.IsPositive() {
 			receiver, err := sdk.AccAddressFromBech32(data.Buyer)
 			if err != nil {
 				return err
@@ -229,7 +363,20 @@ func (k Keeper) OnAcknowledgementBuyOrderPacket(
 				packet.SourceChannel,
 				receiver,
 				finalAmountDenom,
-				packetAck.Purchase,
+				purchase,
+			); err != nil {
+				return err
+			}
+
+			if err := k.CollectFee(
+				ctx,
+				packet.SourcePort,
+				packet.SourceChannel,
+				pairIndex,
+				data.Buyer,
+				types.AttributeValueTaker,
+				finalAmountDenom,
+				takerFee,
 			); err != nil {
 				return err
 			}
@@ -249,6 +396,9 @@ func (k Keeper) OnTimeoutBuyOrderPacket(
 	packet channeltypes.Packet,
 	data types.BuyOrderPacketData,
 ) error {
+	// the order is no longer in flight
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 	// in case of error we mint back the native token
 	receiver, err := sdk.AccAddressFromBech32(data.Buyer)
 	if err != nil {
@@ -261,7 +411,7 @@ func (k Keeper) OnTimeoutBuyOrderPacket(
 		packet.SourceChannel,
 		receiver,
 		data.PriceDenom,
-		data.Amount*data.Price,
+		data.EscrowAmount(),
 	); err != nil {
 		return err
 	}
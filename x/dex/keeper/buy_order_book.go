@@ -8,6 +8,13 @@ import (
 
 // SetBuyOrderBook set a specific buyOrderBook in the store from its index
 func (k Keeper) SetBuyOrderBook(ctx sdk.Context, buyOrderBook types.BuyOrderBook) {
+	// orders are stored individually, only the book metadata is kept here
+	if buyOrderBook.Book != nil {
+		book := *buyOrderBook.Book
+		book.Orders = nil
+		buyOrderBook.Book = &book
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BuyOrderBookKeyPrefix))
 	b := k.cdc.MustMarshal(&buyOrderBook)
 	store.Set(types.BuyOrderBookKey(
@@ -61,3 +68,164 @@ func (k Keeper) GetAllBuyOrderBook(ctx sdk.Context) (list []types.BuyOrderBook)
 
 	return
 }
+
+// AppendBuyOrder appends a new order to the buy order book and saves the book after checking it against
+// the trading rules of the pair. The amount of iceberg orders beyond their display amount is hidden in
+// reserve
+func (k Keeper) AppendBuyOrder(
+	ctx sdk.Context,
+	book types.BuyOrderBook,
+	creator string,
+	amount sdk.Int,
+	price sdk.Dec,
+	expiry types.OrderExpiry,
+	displayAmount sdk.Int,
+) (int32, error) {
+	if err := book.Rules.ValidateOrder(amount, price); err != nil {
+		return 0, err
+	}
+
+	order, err := book.Book.NewOrder(k.GetParams(ctx), creator, amount, price)
+	if err != nil {
+		return 0, err
+	}
+	order.Expiry = expiry
+
+	k.SetIcebergOrder(ctx, book.Index, types.BuySide, order, displayAmount)
+	k.SetBuyOrderBook(ctx, book)
+
+	return order.Id, nil
+}
+
+// MatchesSellOrder returns whether a sell order would match the highest bid of the pair
+func (k Keeper) MatchesSellOrder(ctx sdk.Context, pairIndex string, order types.Order) bool {
+	highestBid, found := k.GetBestOrder(ctx, pairIndex, types.BuySide)
+
+	return found && order.Price.LTE(highestBid.Price)
+}
+
+// CanFillSellOrder returns whether a sell order can be entirely filled against the buy order book of the
+// pair, without modifying the book
+func (k Keeper) CanFillSellOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	order types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+) bool {
+	iterator := k.OrderIterator(ctx, pairIndex, types.BuySide)
+	defer iterator.Close()
+
+	// sum the highest bids as long as there is match
+	matchable := sdk.ZeroInt()
+	sharesLevel := k.pairMatcher(ctx, pairIndex, types.BuySide).SharesLevel()
+	var levelPrice sdk.Dec
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		if order.Price.GT(bid.Price) {
+			break
+		}
+		if isExpired(ctx, bid) {
+			continue
+		}
+
+		// the orders of a level sharing the fills, the order must be matchable once a level is complete
+		if sharesLevel && !levelPrice.IsNil() && !bid.Price.Equal(levelPrice) && matchable.GTE(order.Amount) {
+			return true
+		}
+		levelPrice = bid.Price
+
+		// the resting orders of the creator are skipped if cancelled, otherwise they prevent the fill
+		if types.IsSelfTrade(order, bid) {
+			if selfTradePrevention != types.CancelResting {
+				return false
+			}
+			continue
+		}
+
+		// the hidden reserve of iceberg orders refills the book while filling
+		matchable = matchable.Add(bid.Amount).Add(k.orderReserveAmount(ctx, pairIndex, types.BuySide, bid.Id))
+		if !sharesLevel && matchable.GTE(order.Amount) {
+			return true
+		}
+	}
+
+	return matchable.GTE(order.Amount)
+}
+
+// FillSellOrder fills a sell order against the buy order book of the pair, applying its self-trade
+// prevention mode to the bids of its own creator
+func (k Keeper) FillSellOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	order types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+) (
+	remainingSellOrder types.Order,
+	liquidated []types.Order,
+	gain sdk.Int,
+	selfTrade types.SelfTrade,
+	filled bool,
+) {
+	var liquidatedList []types.Order
+	totalGain := sdk.ZeroInt()
+	remainingSellOrder = order
+	selfTrade = types.NewSelfTrade()
+	matcher := k.pairMatcher(ctx, pairIndex, types.BuySide)
+
+	// liquidate as long as there is match
+	for {
+		var stop bool
+		if matcher.SharesLevel() {
+			remainingSellOrder, stop = k.preventLevelSelfTrades(
+				ctx,
+				pairIndex,
+				types.BuySide,
+				remainingSellOrder,
+				selfTradePrevention,
+				&selfTrade,
+			)
+		} else {
+			remainingSellOrder, stop = k.preventSelfTrade(
+				ctx,
+				pairIndex,
+				types.BuySide,
+				remainingSellOrder,
+				selfTradePrevention,
+				&selfTrade,
+			)
+		}
+		if stop {
+			break
+		}
+
+		var match bool
+		var liquidations []types.Order
+		remainingSellOrder, liquidations, match = k.liquidateLevel(
+			ctx,
+			pairIndex,
+			types.BuySide,
+			remainingSellOrder,
+			matcher,
+		)
+		if !match {
+			break
+		}
+
+		for _, liquidation := range liquidations {
+			totalGain = totalGain.Add(types.QuoteAmount(liquidation.Amount, liquidation.Price))
+		}
+		liquidatedList = append(liquidatedList, liquidations...)
+
+		filled = remainingSellOrder.Amount.IsZero()
+		if filled {
+			break
+		}
+	}
+
+	// the liquidations set the last price of the pair and update its candles
+	k.recordLastPrice(ctx, pairIndex, liquidatedList)
+	k.updateCandles(ctx, pairIndex, liquidatedList)
+
+	return remainingSellOrder, liquidatedList, totalGain, selfTrade, filled
+}
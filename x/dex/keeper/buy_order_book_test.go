@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 
@@ -61,3 +62,227 @@ func TestBuyOrderBookGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllBuyOrderBook(ctx)),
 	)
 }
+
+type fillSellRes struct {
+	Book       []types.Order
+	Remaining  types.Order
+	Liquidated []types.Order
+	Gain       sdk.Int
+	Filled     bool
+}
+
+func TestBuyOrderBookAppendOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	buyBook := types.NewBuyOrderBook("foo", "bar")
+	buyBook.Index = testPairIndex
+
+	// prevent zero amount
+	creator, amount, price := GenOrder()
+	_, err := k.AppendBuyOrder(ctx, buyBook, creator, sdk.ZeroInt(), price, types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrZeroAmount)
+
+	// prevent big amount
+	_, err = k.AppendBuyOrder(ctx, buyBook, creator, types.DefaultMaxAmount.AddRaw(1), price, types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrMaxAmount)
+
+	// prevent zero price
+	_, err = k.AppendBuyOrder(ctx, buyBook, creator, amount, sdk.ZeroDec(), types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrZeroPrice)
+
+	// prevent big price
+	_, err = k.AppendBuyOrder(ctx, buyBook, creator, amount, types.DefaultMaxPrice.Add(sdk.OneDec()), types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrMaxPrice)
+
+	// can append buy orders
+	for i := 0; i < 20; i++ {
+		// append a new order
+		creator, amount, price := GenOrder()
+		newOrder := types.Order{
+			Id:      buyBook.Book.IdCount,
+			Creator: creator,
+			Amount:  amount,
+			Price:   price,
+		}
+		orderID, err := k.AppendBuyOrder(ctx, buyBook, creator, amount, price, types.OrderExpiry{}, sdk.ZeroInt())
+
+		// assert checks
+		require.NoError(t, err)
+		require.Equal(t, newOrder.Id, orderID)
+		stored, found := k.GetOrder(ctx, testPairIndex, types.BuySide, price, orderID)
+		require.True(t, found)
+		require.True(t, newOrder.Amount.Equal(stored.Amount))
+		require.True(t, newOrder.Price.Equal(stored.Price))
+	}
+
+	// assert checks
+	orders := k.GetAllOrder(ctx, testPairIndex, types.BuySide)
+	require.Len(t, orders, 20)
+	require.True(t, sort.SliceIsSorted(orders, func(i, j int) bool {
+		return orders[i].Price.GT(orders[j].Price)
+	}))
+	rst, found := k.GetBuyOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.Equal(t, int32(20), rst.Book.IdCount)
+}
+
+func simulateFillSellOrder(
+	t *testing.T,
+	inputList []types.Order,
+	inputOrder types.Order,
+	expected fillSellRes,
+) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.BuySide, inputList)
+
+	remaining, liquidated, gain, _, filled := k.FillSellOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+
+	require.Equal(t, expected.Book, k.GetAllOrder(ctx, testPairIndex, types.BuySide))
+	require.Equal(t, expected.Remaining, remaining)
+	require.Equal(t, expected.Liquidated, liquidated)
+	require.Equal(t, expected.Gain, gain)
+	require.Equal(t, expected.Filled, filled)
+}
+
+func TestFillSellOrder(t *testing.T) {
+	var inputBook []types.Order
+
+	// empty book
+	inputOrder := types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(30), Price: sdk.NewDec(30)}
+	expected := fillSellRes{
+		Book:       []types.Order(nil),
+		Remaining:  inputOrder,
+		Liquidated: []types.Order(nil),
+		Gain:       sdk.NewInt(0),
+		Filled:     false,
+	}
+	simulateFillSellOrder(t, inputBook, inputOrder, expected)
+
+	// no match
+	inputBook = []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+	}
+	expected = fillSellRes{
+		Book:       inputBook,
+		Remaining:  inputOrder,
+		Liquidated: []types.Order(nil),
+		Gain:       sdk.NewInt(0),
+		Filled:     false,
+	}
+	simulateFillSellOrder(t, inputBook, inputOrder, expected)
+
+	// first order liquidated, not filled
+	inputOrder = types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(60), Price: sdk.NewDec(22)}
+	expected = fillSellRes{
+		Book: []types.Order{
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		},
+		Remaining: types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(10), Price: sdk.NewDec(22)},
+		Liquidated: []types.Order{
+			{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		},
+		Gain:   sdk.NewInt(50 * 25),
+		Filled: false,
+	}
+	simulateFillSellOrder(t, inputBook, inputOrder, expected)
+
+	// filled with two order
+	inputOrder = types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(60), Price: sdk.NewDec(18)}
+	expected = fillSellRes{
+		Book: []types.Order{
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(190), Price: sdk.NewDec(20)},
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		},
+		Remaining: types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(0), Price: sdk.NewDec(18)},
+		Liquidated: []types.Order{
+			{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(10), Price: sdk.NewDec(20)},
+		},
+		Gain:   sdk.NewInt(50*25 + 10*20),
+		Filled: true,
+	}
+	simulateFillSellOrder(t, inputBook, inputOrder, expected)
+
+	// not filled, buy order book liquidated
+	inputOrder = types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(300), Price: sdk.NewDec(10)}
+	expected = fillSellRes{
+		Book:      []types.Order(nil),
+		Remaining: types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(20), Price: sdk.NewDec(10)},
+		Liquidated: []types.Order{
+			{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		},
+		Gain:   sdk.NewInt(50*25 + 200*20 + 30*15),
+		Filled: false,
+	}
+	simulateFillSellOrder(t, inputBook, inputOrder, expected)
+
+	// market order without protection price sweeps the book
+	inputOrder = types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(260), Price: sdk.ZeroDec()}
+	expected = fillSellRes{
+		Book: []types.Order{
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(20), Price: sdk.NewDec(15)},
+		},
+		Remaining: types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(0), Price: sdk.ZeroDec()},
+		Liquidated: []types.Order{
+			{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(10), Price: sdk.NewDec(15)},
+		},
+		Gain:   sdk.NewInt(50*25 + 200*20 + 10*15),
+		Filled: true,
+	}
+	simulateFillSellOrder(t, inputBook, inputOrder, expected)
+}
+
+func TestCanFillSellOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+
+	// empty book
+	order := types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(10)}
+	require.False(t, k.CanFillSellOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	inputBook := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+	}
+	setOrders(k, ctx, types.BuySide, inputBook)
+
+	// no match
+	order = types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(30)}
+	require.False(t, k.CanFillSellOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	// filled with two orders
+	order = types.Order{Amount: sdk.NewInt(250), Price: sdk.NewDec(20)}
+	require.True(t, k.CanFillSellOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	// not enough bids above the price
+	order = types.Order{Amount: sdk.NewInt(251), Price: sdk.NewDec(20)}
+	require.False(t, k.CanFillSellOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	// the book is left untouched
+	require.Equal(t, inputBook, k.GetAllOrder(ctx, testPairIndex, types.BuySide))
+}
+
+func TestMatchesSellOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+
+	// empty book
+	order := types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(10)}
+	require.False(t, k.MatchesSellOrder(ctx, testPairIndex, order))
+
+	setOrders(k, ctx, types.BuySide, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+	})
+
+	order = types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(26)}
+	require.False(t, k.MatchesSellOrder(ctx, testPairIndex, order))
+
+	order = types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(25)}
+	require.True(t, k.MatchesSellOrder(ctx, testPairIndex, order))
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"interchange-nel/x/dex/types"
+)
+
+// SetCandle set a specific candle in the store from its index
+func (k Keeper) SetCandle(ctx sdk.Context, candle types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	b := k.cdc.MustMarshal(&candle)
+	store.Set(types.CandleKey(
+		candle.PairIndex,
+		candle.Interval,
+		candle.OpenTime,
+	), b)
+}
+
+// GetCandle returns a candle from its index
+func (k Keeper) GetCandle(
+	ctx sdk.Context,
+	pairIndex string,
+	interval types.CandleInterval,
+	openTime uint64,
+) (val types.Candle, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+
+	b := store.Get(types.CandleKey(
+		pairIndex,
+		interval,
+		openTime,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveCandle removes a candle from the store
+func (k Keeper) RemoveCandle(
+	ctx sdk.Context,
+	pairIndex string,
+	interval types.CandleInterval,
+	openTime uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	store.Delete(types.CandleKey(
+		pairIndex,
+		interval,
+		openTime,
+	))
+}
+
+// GetAllCandle returns all candle
+func (k Keeper) GetAllCandle(ctx sdk.Context) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// updateCandles adds the liquidations of a fill to the candles of the pair at each interval, opening
+// the candles of the current intervals if needed
+func (k Keeper) updateCandles(ctx sdk.Context, pairIndex string, liquidated []types.Order) {
+	if len(liquidated) == 0 {
+		return
+	}
+
+	for _, interval := range types.CandleIntervals {
+		openTime := interval.OpenTime(ctx.BlockTime())
+		candle, found := k.GetCandle(ctx, pairIndex, interval, openTime)
+		if !found {
+			candle = types.NewCandle(pairIndex, interval, openTime, liquidated[0].Price)
+		}
+
+		for _, liquidation := range liquidated {
+			candle.AddTrade(liquidation.Price, liquidation.Amount)
+		}
+		k.SetCandle(ctx, candle)
+	}
+}
+
+// PruneCandles removes the candles whose interval ended before the candle horizon. The pairs with candles
+// are the pairs with a last price, both being recorded by the same fills. The candles are kept forever
+// if the horizon is zero
+func (k Keeper) PruneCandles(ctx sdk.Context) {
+	horizon := k.CandleHorizon(ctx)
+	if horizon <= 0 {
+		return
+	}
+
+	blockTime := ctx.BlockTime().UnixNano()
+	if blockTime <= horizon.Nanoseconds() {
+		return
+	}
+	expiry := uint64(blockTime - horizon.Nanoseconds())
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	for _, lastPrice := range k.GetAllLastPrice(ctx) {
+		for _, interval := range types.CandleIntervals {
+			seriesStore := prefix.NewStore(store, types.CandleSeriesKey(lastPrice.PairIndex, interval))
+			iterator := sdk.KVStorePrefixIterator(seriesStore, []byte{})
+
+			// collect the expired candles first as removing them modifies the store
+			var expired [][]byte
+			for ; iterator.Valid(); iterator.Next() {
+				var candle types.Candle
+				k.cdc.MustUnmarshal(iterator.Value(), &candle)
+				if candle.CloseTime() > expiry {
+					break
+				}
+				expired = append(expired, iterator.Key())
+			}
+			iterator.Close()
+
+			for _, key := range expired {
+				seriesStore.Delete(key)
+			}
+		}
+	}
+}
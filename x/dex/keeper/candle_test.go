@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/testutil/nullify"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+// candleStart is the block time of the first fill of the candle tests
+var candleStart = time.Date(2022, 5, 10, 13, 45, 30, 0, time.UTC)
+
+// fillCandleOrders fills two buy orders a minute apart against the asks at 15, 20 and 25
+func fillCandleOrders(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// fills 30 at 15 and 10 at 20
+	_, _, _, _, filled := k.FillBuyOrder(
+		ctx.WithBlockTime(candleStart),
+		testPairIndex,
+		types.Order{Creator: MockAccount("10"), Amount: sdk.NewInt(40), Price: sdk.NewDec(30)},
+		types.CancelResting,
+	)
+	require.True(t, filled)
+
+	// fills 190 at 20 and 10 at 25
+	_, _, _, _, filled = k.FillBuyOrder(
+		ctx.WithBlockTime(candleStart.Add(time.Minute)),
+		testPairIndex,
+		types.Order{Creator: MockAccount("10"), Amount: sdk.NewInt(200), Price: sdk.NewDec(30)},
+		types.CancelResting,
+	)
+	require.True(t, filled)
+}
+
+func TestUpdateCandles(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	fillCandleOrders(t, k, ctx)
+
+	minute := types.OneMinute.OpenTime(candleStart)
+	candle, found := k.GetCandle(ctx, testPairIndex, types.OneMinute, minute)
+	require.True(t, found)
+	require.Equal(t, types.Candle{
+		PairIndex: testPairIndex,
+		Interval:  types.OneMinute,
+		OpenTime:  minute,
+		Open:      sdk.NewDec(15),
+		High:      sdk.NewDec(20),
+		Low:       sdk.NewDec(15),
+		Close:     sdk.NewDec(20),
+		Volume:    sdk.NewInt(40),
+	}, nullify.Fill(candle))
+
+	nextMinute := types.OneMinute.OpenTime(candleStart.Add(time.Minute))
+	candle, found = k.GetCandle(ctx, testPairIndex, types.OneMinute, nextMinute)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(20), candle.Open)
+	require.Equal(t, sdk.NewDec(25), candle.Close)
+	require.Equal(t, sdk.NewInt(200), candle.Volume)
+
+	// both fills are in the same hour and day
+	for _, interval := range []types.CandleInterval{types.OneHour, types.OneDay} {
+		candle, found = k.GetCandle(ctx, testPairIndex, interval, interval.OpenTime(candleStart))
+		require.True(t, found)
+		require.Equal(t, types.Candle{
+			PairIndex: testPairIndex,
+			Interval:  interval,
+			OpenTime:  interval.OpenTime(candleStart),
+			Open:      sdk.NewDec(15),
+			High:      sdk.NewDec(25),
+			Low:       sdk.NewDec(15),
+			Close:     sdk.NewDec(25),
+			Volume:    sdk.NewInt(240),
+		}, nullify.Fill(candle))
+	}
+	require.Len(t, k.GetAllCandle(ctx), 4)
+}
+
+func TestPruneCandles(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	params := types.DefaultParams()
+	params.CandleHorizon = time.Hour
+	k.SetParams(ctx, params)
+	fillCandleOrders(t, k, ctx)
+
+	// the candle of the first minute ends an hour before
+	k.PruneCandles(ctx.WithBlockTime(candleStart.Add(time.Hour)))
+	require.Len(t, k.GetAllCandle(ctx), 4)
+	k.PruneCandles(ctx.WithBlockTime(candleStart.Add(time.Hour + 30*time.Second)))
+	require.Len(t, k.GetAllCandle(ctx), 3)
+	_, found := k.GetCandle(ctx, testPairIndex, types.OneMinute, types.OneMinute.OpenTime(candleStart))
+	require.False(t, found)
+
+	// the candles of the hour and of the second minute are pruned an hour after they end
+	k.PruneCandles(ctx.WithBlockTime(candleStart.Add(2 * time.Hour)))
+	require.Len(t, k.GetAllCandle(ctx), 1)
+
+	// candles are kept forever without horizon
+	params.CandleHorizon = 0
+	k.SetParams(ctx, params)
+	k.PruneCandles(ctx.WithBlockTime(candleStart.Add(48 * time.Hour)))
+	require.Len(t, k.GetAllCandle(ctx), 1)
+}
@@ -107,8 +107,12 @@ func (k Keeper) OnRecvCreatePairPacket(
 	// create a new buy order book for source and target denoms
 	book := types.NewBuyOrderBook(data.SourceDenom, data.TargetDenom)
 
-	// assign order book index
+	// assign order book index, the port and channel escrowing its orders and the trading rules of the
+	// pair, the fee rates of the params apply until governance overrides them
 	book.Index = pairIndex
+	book.Port = packet.DestinationPort
+	book.Channel = packet.DestinationChannel
+	book.Rules = data.Rules.WithoutFeeOverrides()
 
 	// save the order book to the store
 	k.SetBuyOrderBook(ctx, book)
@@ -141,6 +145,9 @@ func (k Keeper) OnAcknowledgementCreatePairPacket(
 
 		book := types.NewSellOrderBook(data.SourceDenom, data.TargetDenom)
 		book.Index = pairIndex
+		book.Port = packet.SourcePort
+		book.Channel = packet.SourceChannel
+		book.Rules = data.Rules.WithoutFeeOverrides()
 
 		k.SetSellOrderBook(ctx, book)
 
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func TestOnRecvCreatePairPacketFeeOverrides(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	feeRate := sdk.ZeroDec()
+	rules := types.PairRules{LotSize: sdk.NewInt(10), MakerFeeRate: &feeRate, TakerFeeRate: &feeRate}
+
+	// the fee rates set by the creator of the pair are dropped, only governance can override them
+	_, err := k.OnRecvCreatePairPacket(
+		ctx,
+		channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0", DestinationPort: "dex", DestinationChannel: "channel-1"},
+		types.CreatePairPacketData{SourceDenom: "foo", TargetDenom: "bar", Rules: rules},
+	)
+	require.NoError(t, err)
+
+	book, found := k.GetBuyOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.True(t, rules.LotSize.Equal(book.Rules.LotSize))
+	require.False(t, book.Rules.HasFeeOverrides())
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+
+	"interchange-nel/x/dex/types"
+)
+
+// FeeRates returns the maker and taker fee rates of a pair with the given rules
+func (k Keeper) FeeRates(ctx sdk.Context, rules types.PairRules) (maker sdk.Dec, taker sdk.Dec) {
+	return rules.FeeRates(k.GetParams(ctx))
+}
+
+// CollectFee pays a trading fee to the fee collector account. The fee is part of the proceeds of the
+// payer, so it is minted if it is a voucher and unlocked from escrow otherwise, like the proceeds
+func (k Keeper) CollectFee(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	pairIndex string,
+	payer string,
+	liquidity string,
+	denom string,
+	fee sdk.Int,
+) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, fee))
+	if isIBCToken(denom) {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx, types.ModuleName, types.FeeCollectorName, coins,
+		); err != nil {
+			return err
+		}
+	} else {
+		escrowAddress := ibctransfertypes.GetEscrowAddress(port, channel)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, escrowAddress, types.FeeCollectorName, coins,
+		); err != nil {
+			return err
+		}
+	}
+
+	k.recordFeeCollected(ctx, coins[0])
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTradingFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPairIndex, pairIndex),
+			sdk.NewAttribute(types.AttributeKeyPayer, payer),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity),
+			sdk.NewAttribute(types.AttributeKeyFee, coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// payMaker pays the proceeds of a filled resting order to its creator minus the maker fee, and returns
+// the fee
+func (k Keeper) payMaker(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	pairIndex string,
+	makerFeeRate sdk.Dec,
+	creator string,
+	denom string,
+	proceeds sdk.Int,
+) (sdk.Int, error) {
+	addr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	fee := types.FeeAmount(proceeds, makerFeeRate)
+	if err := k.SafeMint(ctx, port, channel, addr, denom, proceeds.Sub(fee)); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.CollectFee(ctx, port, channel, pairIndex, creator, types.AttributeValueMaker, denom, fee); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return fee, nil
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"interchange-nel/x/dex/types"
+)
+
+// SetFeeStats set a specific feeStats in the store from its denom
+func (k Keeper) SetFeeStats(ctx sdk.Context, feeStats types.FeeStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&feeStats)
+	store.Set(types.FeeStatsKey(
+		feeStats.Denom,
+	), b)
+}
+
+// GetFeeStats returns a feeStats from its denom
+func (k Keeper) GetFeeStats(
+	ctx sdk.Context,
+	denom string,
+
+) (val types.FeeStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeStatsKeyPrefix))
+
+	b := store.Get(types.FeeStatsKey(
+		denom,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFeeStats returns all feeStats
+func (k Keeper) GetAllFeeStats(ctx sdk.Context) (list []types.FeeStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getOrNewFeeStats returns the feeStats of a denom, zero if no fee was collected in it
+func (k Keeper) getOrNewFeeStats(ctx sdk.Context, denom string) types.FeeStats {
+	feeStats, found := k.GetFeeStats(ctx, denom)
+	if !found {
+		return types.NewFeeStats(denom)
+	}
+
+	return feeStats
+}
+
+// recordFeeCollected adds a fee collected to the total of its denom
+func (k Keeper) recordFeeCollected(ctx sdk.Context, fee sdk.Coin) {
+	feeStats := k.getOrNewFeeStats(ctx, fee.Denom)
+	feeStats.Collected = feeStats.Collected.Add(fee.Amount)
+	k.SetFeeStats(ctx, feeStats)
+}
+
+// DistributeFees splits the fees collected during the block following the fee distribution param: the
+// share of the stakers is sent to the fee collector of the distribution module, the one of the community
+// pool funds it and the rest is burned. The fees are left to the next block if they cannot be distributed
+func (k Keeper) DistributeFees(ctx sdk.Context) {
+	collectorAddress := authtypes.NewModuleAddress(types.FeeCollectorName)
+	collected := k.bankKeeper.GetAllBalances(ctx, collectorAddress)
+	if collected.IsZero() {
+		return
+	}
+
+	distribution := k.FeeDistribution(ctx)
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	var toStakers, toCommunityPool, toBurn []sdk.Coin
+	for _, coin := range collected {
+		stakers, communityPool, burn := distribution.Split(coin.Amount)
+		toStakers = append(toStakers, sdk.NewCoin(coin.Denom, stakers))
+		toCommunityPool = append(toCommunityPool, sdk.NewCoin(coin.Denom, communityPool))
+		toBurn = append(toBurn, sdk.NewCoin(coin.Denom, burn))
+
+		feeStats := k.getOrNewFeeStats(cacheCtx, coin.Denom)
+		feeStats.Stakers = feeStats.Stakers.Add(stakers)
+		feeStats.CommunityPool = feeStats.CommunityPool.Add(communityPool)
+		feeStats.Burned = feeStats.Burned.Add(burn)
+		k.SetFeeStats(cacheCtx, feeStats)
+	}
+
+	if err := k.distributeFees(
+		cacheCtx,
+		collectorAddress,
+		sdk.NewCoins(toStakers...),
+		sdk.NewCoins(toCommunityPool...),
+		sdk.NewCoins(toBurn...),
+	); err != nil {
+		k.Logger(ctx).Error("cannot distribute fees", "fees", collected.String(), "error", err)
+		return
+	}
+	writeCache()
+}
+
+func (k Keeper) distributeFees(
+	ctx sdk.Context,
+	collectorAddress sdk.AccAddress,
+	toStakers sdk.Coins,
+	toCommunityPool sdk.Coins,
+	toBurn sdk.Coins,
+) error {
+	if !toStakers.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx, types.FeeCollectorName, authtypes.FeeCollectorName, toStakers,
+		); err != nil {
+			return err
+		}
+	}
+
+	if !toCommunityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, toCommunityPool, collectorAddress); err != nil {
+			return err
+		}
+	}
+
+	if !toBurn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.FeeCollectorName, toBurn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/testutil/nullify"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func createNFeeStats(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.FeeStats {
+	items := make([]types.FeeStats, n)
+	for i := range items {
+		items[i] = types.NewFeeStats("denom" + strconv.Itoa(i))
+		items[i].Collected = sdk.NewInt(int64(100 * (i + 1)))
+		items[i].Stakers = sdk.NewInt(int64(50 * (i + 1)))
+
+		keeper.SetFeeStats(ctx, items[i])
+	}
+	return items
+}
+
+func TestFeeStatsGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNFeeStats(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetFeeStats(ctx,
+			item.Denom,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+
+	_, found := keeper.GetFeeStats(ctx, "foo")
+	require.False(t, found)
+}
+
+func TestFeeStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNFeeStats(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllFeeStats(ctx)),
+	)
+}
@@ -27,6 +27,7 @@ func (k Keeper) BuyOrderBookAll(c context.Context, req *types.QueryAllBuyOrderBo
 		if err := k.cdc.Unmarshal(value, &buyOrderBook); err != nil {
 			return err
 		}
+		k.LoadOrders(ctx, buyOrderBook.Index, types.BuySide, buyOrderBook.Book)
 
 		buyOrderBooks = append(buyOrderBooks, buyOrderBook)
 		return nil
@@ -52,6 +53,7 @@ func (k Keeper) BuyOrderBook(c context.Context, req *types.QueryGetBuyOrderBookR
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
+	k.LoadOrders(ctx, val.Index, types.BuySide, val.Book)
 
 	return &types.QueryGetBuyOrderBookResponse{BuyOrderBook: val}, nil
 }
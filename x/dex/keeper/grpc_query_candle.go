@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange-nel/x/dex/types"
+)
+
+func (k Keeper) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.CandleInterval_name[int32(req.Interval)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid candle interval")
+	}
+	if req.EndTime != 0 && req.EndTime <= req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+
+	var candles []types.Candle
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	seriesStore := prefix.NewStore(store, types.CandleSeriesKey(req.PairIndex, req.Interval))
+
+	// the series is ordered by open time, only the candles opened in the time range are paginated
+	pageRes, err := query.FilteredPaginate(seriesStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		openTime := sdk.BigEndianToUint64(key)
+		if openTime < req.StartTime || (req.EndTime != 0 && openTime >= req.EndTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			var candle types.Candle
+			if err := k.cdc.Unmarshal(value, &candle); err != nil {
+				return false, err
+			}
+			candles = append(candles, candle)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candle: candles, Pagination: pageRes}, nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/testutil/nullify"
+	"interchange-nel/x/dex/types"
+)
+
+func TestCandlesQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	fillCandleOrders(t, keeper, ctx)
+	first, found := keeper.GetCandle(ctx, testPairIndex, types.OneMinute, types.OneMinute.OpenTime(candleStart))
+	require.True(t, found)
+	second, found := keeper.GetCandle(ctx, testPairIndex, types.OneMinute, types.OneMinute.OpenTime(candleStart.Add(time.Minute)))
+	require.True(t, found)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryCandlesRequest
+		expected []types.Candle
+	}{
+		{
+			desc:     "All",
+			request:  &types.QueryCandlesRequest{PairIndex: testPairIndex, Interval: types.OneMinute},
+			expected: []types.Candle{first, second},
+		},
+		{
+			desc: "StartTime",
+			request: &types.QueryCandlesRequest{
+				PairIndex: testPairIndex,
+				Interval:  types.OneMinute,
+				StartTime: second.OpenTime,
+			},
+			expected: []types.Candle{second},
+		},
+		{
+			desc: "EndTime",
+			request: &types.QueryCandlesRequest{
+				PairIndex: testPairIndex,
+				Interval:  types.OneMinute,
+				EndTime:   second.OpenTime,
+			},
+			expected: []types.Candle{first},
+		},
+		{
+			desc: "Paginated",
+			request: &types.QueryCandlesRequest{
+				PairIndex:  testPairIndex,
+				Interval:   types.OneMinute,
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expected: []types.Candle{first},
+		},
+		{
+			desc:    "OtherPair",
+			request: &types.QueryCandlesRequest{PairIndex: "foo", Interval: types.OneMinute},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Candles(wctx, tc.request)
+			require.NoError(t, err)
+			require.Equal(t, nullify.Fill(tc.expected), nullify.Fill(response.Candle))
+		})
+	}
+
+	for _, request := range []*types.QueryCandlesRequest{
+		nil,
+		{PairIndex: testPairIndex, Interval: types.CandleInterval(3)},
+		{PairIndex: testPairIndex, StartTime: 10, EndTime: 10},
+	} {
+		_, err := keeper.Candles(wctx, request)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange-nel/x/dex/types"
+)
+
+func (k Keeper) Depth(c context.Context, req *types.QueryDepthRequest) (*types.QueryDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bucketSize := sdk.ZeroDec()
+	if req.BucketSize != "" {
+		var err error
+		bucketSize, err = sdk.NewDecFromStr(req.BucketSize)
+		if err != nil || bucketSize.IsNegative() {
+			return nil, status.Error(codes.InvalidArgument, "invalid bucket size")
+		}
+	}
+
+	// the local books of the pair hold the bids or the asks depending on the chain
+	_, buyFound := k.GetBuyOrderBook(ctx, req.Index)
+	_, sellFound := k.GetSellOrderBook(ctx, req.Index)
+	if !buyFound && !sellFound {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryDepthResponse{
+		Index: req.Index,
+		Bids:  k.DepthLevels(ctx, req.Index, types.BuySide, req.Limit, bucketSize),
+		Asks:  k.DepthLevels(ctx, req.Index, types.SellSide, req.Limit, bucketSize),
+	}, nil
+}
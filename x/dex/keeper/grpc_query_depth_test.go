@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+var depthBuyBook = []types.Order{
+	{Id: 3, Creator: MockAccount("3"), Amount: sdk.NewInt(10), Price: sdk.NewDecWithPrec(205, 1)},
+	{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(20)},
+	{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+	{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(15)},
+}
+
+func depthLevel(price sdk.Dec, amount int64, orderCount uint64) types.DepthLevel {
+	return types.DepthLevel{Price: price, Amount: sdk.NewInt(amount), OrderCount: orderCount}
+}
+
+func TestDepthQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	book := types.NewBuyOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	keeper.SetBuyOrderBook(ctx, book)
+	setOrders(keeper, ctx, types.BuySide, depthBuyBook)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryDepthRequest
+		response *types.QueryDepthResponse
+		err      error
+	}{
+		{
+			desc:    "Levels",
+			request: &types.QueryDepthRequest{Index: testPairIndex},
+			response: &types.QueryDepthResponse{
+				Index: testPairIndex,
+				Bids: []types.DepthLevel{
+					depthLevel(sdk.NewDecWithPrec(205, 1), 10, 1),
+					depthLevel(sdk.NewDec(20), 230, 2),
+					depthLevel(sdk.NewDec(15), 50, 1),
+				},
+			},
+		},
+		{
+			desc:    "Limit",
+			request: &types.QueryDepthRequest{Index: testPairIndex, Limit: 2},
+			response: &types.QueryDepthResponse{
+				Index: testPairIndex,
+				Bids: []types.DepthLevel{
+					depthLevel(sdk.NewDecWithPrec(205, 1), 10, 1),
+					depthLevel(sdk.NewDec(20), 230, 2),
+				},
+			},
+		},
+		{
+			desc:    "Buckets",
+			request: &types.QueryDepthRequest{Index: testPairIndex, BucketSize: "10"},
+			response: &types.QueryDepthResponse{
+				Index: testPairIndex,
+				Bids: []types.DepthLevel{
+					depthLevel(sdk.NewDec(20), 240, 3),
+					depthLevel(sdk.NewDec(10), 50, 1),
+				},
+			},
+		},
+		{
+			desc:    "InvalidBucketSize",
+			request: &types.QueryDepthRequest{Index: testPairIndex, BucketSize: "-1"},
+			err:     status.Error(codes.InvalidArgument, "invalid bucket size"),
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryDepthRequest{Index: "foo"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Depth(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange-nel/x/dex/types"
+)
+
+func (k Keeper) FeeStatsAll(c context.Context, req *types.QueryAllFeeStatsRequest) (*types.QueryAllFeeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var feeStatsList []types.FeeStats
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	feeStatsStore := prefix.NewStore(store, types.KeyPrefix(types.FeeStatsKeyPrefix))
+
+	pageRes, err := query.Paginate(feeStatsStore, req.Pagination, func(key []byte, value []byte) error {
+		var feeStats types.FeeStats
+		if err := k.cdc.Unmarshal(value, &feeStats); err != nil {
+			return err
+		}
+
+		feeStatsList = append(feeStatsList, feeStats)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFeeStatsResponse{FeeStats: feeStatsList, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FeeStats(c context.Context, req *types.QueryGetFeeStatsRequest) (*types.QueryGetFeeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetFeeStats(
+		ctx,
+		req.Denom,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetFeeStatsResponse{FeeStats: val}, nil
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/testutil/nullify"
+	"interchange-nel/x/dex/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestFeeStatsQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNFeeStats(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetFeeStatsRequest
+		response *types.QueryGetFeeStatsResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetFeeStatsRequest{
+				Denom: msgs[0].Denom,
+			},
+			response: &types.QueryGetFeeStatsResponse{FeeStats: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetFeeStatsRequest{
+				Denom: msgs[1].Denom,
+			},
+			response: &types.QueryGetFeeStatsResponse{FeeStats: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetFeeStatsRequest{
+				Denom: "foo",
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.FeeStats(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestFeeStatsQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNFeeStats(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllFeeStatsRequest {
+		return &types.QueryAllFeeStatsRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.FeeStatsAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FeeStats), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FeeStats),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.FeeStatsAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FeeStats), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FeeStats),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.FeeStatsAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.FeeStats),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.FeeStatsAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
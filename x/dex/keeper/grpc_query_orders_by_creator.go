@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange-nel/x/dex/types"
+)
+
+func (k Keeper) OrdersByCreator(c context.Context, req *types.QueryOrdersByCreatorRequest) (*types.QueryOrdersByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var orders []types.OpenOrder
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	creatorStore := prefix.NewStore(
+		store,
+		append(types.KeyPrefix(types.OrderCreatorIndexKeyPrefix), types.OrderCreatorKey(req.Creator)...),
+	)
+
+	pageRes, err := query.Paginate(creatorStore, req.Pagination, func(key []byte, value []byte) error {
+		var location types.OrderLocation
+		if err := k.cdc.Unmarshal(value, &location); err != nil {
+			return err
+		}
+
+		side := types.OrderSide(location.Side)
+		order, found := k.GetOrder(ctx, location.PairIndex, side, location.Price, location.TimePriority())
+		if !found {
+			return fmt.Errorf("order %d of pair %s not found", location.Id, location.PairIndex)
+		}
+
+		orders = append(orders, k.openOrder(ctx, location.PairIndex, side, order))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOrdersByCreatorResponse{Order: orders, Pagination: pageRes}, nil
+}
+
+// openOrder returns an order resting on one side of a pair as listed for its creator, its remaining
+// amount including its hidden reserve. The orders placed without original amount report their remaining
+// amount instead
+func (k Keeper) openOrder(ctx sdk.Context, pairIndex string, side types.OrderSide, order types.Order) types.OpenOrder {
+	amount := order.Amount.Add(k.orderReserveAmount(ctx, pairIndex, side, order.Id))
+	originalAmount := amount
+	if order.OriginalAmount != nil {
+		originalAmount = *order.OriginalAmount
+	}
+
+	return types.OpenOrder{
+		PairIndex:      pairIndex,
+		Side:           uint32(side),
+		OrderId:        order.Id,
+		Amount:         amount,
+		Price:          order.Price,
+		OriginalAmount: originalAmount,
+	}
+}
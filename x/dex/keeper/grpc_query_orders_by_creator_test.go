@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func TestOrdersByCreatorQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	sellBook := types.NewSellOrderBook("foo", "bar")
+	sellBook.Index = testPairIndex
+	keeper.SetSellOrderBook(ctx, sellBook)
+	buyBook := types.NewBuyOrderBook("foo", "bar")
+	buyBook.Index = testPairIndex
+	keeper.SetBuyOrderBook(ctx, buyBook)
+	creator := MockAccount("0")
+
+	// an iceberg ask, partially filled, and a bid of the creator
+	askID, err := keeper.AppendSellOrder(ctx, sellBook, creator, sdk.NewInt(100), sdk.NewDec(20), types.OrderExpiry{}, sdk.NewInt(30))
+	require.NoError(t, err)
+	_, err = keeper.AppendSellOrder(ctx, sellBook, MockAccount("1"), sdk.NewInt(20), sdk.NewDec(20), types.OrderExpiry{}, sdk.ZeroInt())
+	require.NoError(t, err)
+	bidID, err := keeper.AppendBuyOrder(ctx, buyBook, creator, sdk.NewInt(50), sdk.NewDec(10), types.OrderExpiry{}, sdk.ZeroInt())
+	require.NoError(t, err)
+	_, _, _, _, filled := keeper.FillBuyOrder(ctx, testPairIndex, types.Order{
+		Creator: MockAccount("2"),
+		Amount:  sdk.NewInt(10),
+		Price:   sdk.NewDec(20),
+	}, types.CancelResting)
+	require.True(t, filled)
+
+	// orders without original amount report their remaining amount
+	setOrders(keeper, ctx, types.BuySide, []types.Order{
+		{Id: 5, Creator: creator, Amount: sdk.NewInt(15), Price: sdk.NewDec(5)},
+	})
+
+	expected := []types.OpenOrder{
+		{
+			PairIndex:      testPairIndex,
+			Side:           uint32(types.BuySide),
+			OrderId:        bidID,
+			Amount:         sdk.NewInt(50),
+			Price:          sdk.NewDec(10),
+			OriginalAmount: sdk.NewInt(50),
+		},
+		{
+			PairIndex:      testPairIndex,
+			Side:           uint32(types.BuySide),
+			OrderId:        5,
+			Amount:         sdk.NewInt(15),
+			Price:          sdk.NewDec(5),
+			OriginalAmount: sdk.NewInt(15),
+		},
+		{
+			PairIndex:      testPairIndex,
+			Side:           uint32(types.SellSide),
+			OrderId:        askID,
+			Amount:         sdk.NewInt(90),
+			Price:          sdk.NewDec(20),
+			OriginalAmount: sdk.NewInt(100),
+		},
+	}
+
+	response, err := keeper.OrdersByCreator(wctx, &types.QueryOrdersByCreatorRequest{Creator: creator})
+	require.NoError(t, err)
+	require.Len(t, response.Order, len(expected))
+	for i := range expected {
+		requireOpenOrderEqual(t, expected[i], response.Order[i])
+	}
+
+	// sorted and paginated by pair, side and ID
+	response, err = keeper.OrdersByCreator(wctx, &types.QueryOrdersByCreatorRequest{
+		Creator:    creator,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Order, 1)
+	requireOpenOrderEqual(t, expected[1], response.Order[0])
+	require.Equal(t, uint64(3), response.Pagination.Total)
+
+	response, err = keeper.OrdersByCreator(wctx, &types.QueryOrdersByCreatorRequest{Creator: MockAccount("3")})
+	require.NoError(t, err)
+	require.Empty(t, response.Order)
+
+	_, err = keeper.OrdersByCreator(wctx, nil)
+	require.Error(t, err)
+}
+
+func requireOpenOrderEqual(t *testing.T, expected types.OpenOrder, actual types.OpenOrder) {
+	require.Equal(t, expected.PairIndex, actual.PairIndex)
+	require.Equal(t, expected.Side, actual.Side)
+	require.Equal(t, expected.OrderId, actual.OrderId)
+	require.True(t, expected.Amount.Equal(actual.Amount), "amount %s, expected %s", actual.Amount, expected.Amount)
+	require.True(t, expected.Price.Equal(actual.Price))
+	require.True(t, expected.OriginalAmount.Equal(actual.OriginalAmount), "original amount %s, expected %s", actual.OriginalAmount, expected.OriginalAmount)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange-nel/x/dex/types"
+)
+
+func (k Keeper) PendingOrders(c context.Context, req *types.QueryPendingOrdersRequest) (*types.QueryPendingOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingOrders []types.PendingOrder
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingOrderStore := prefix.NewStore(store, types.KeyPrefix(types.PendingOrderKeyPrefix))
+
+	pageRes, err := query.Paginate(pendingOrderStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingOrder types.PendingOrder
+		if err := k.cdc.Unmarshal(value, &pendingOrder); err != nil {
+			return err
+		}
+
+		pendingOrders = append(pendingOrders, withPendingStatus(ctx, pendingOrder))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingOrdersResponse{PendingOrder: pendingOrders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingOrdersByAccount(c context.Context, req *types.QueryPendingOrdersByAccountRequest) (*types.QueryPendingOrdersByAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingOrders []types.PendingOrder
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingOrderStore := prefix.NewStore(store, types.KeyPrefix(types.PendingOrderKeyPrefix))
+	accountStore := prefix.NewStore(
+		store,
+		append(types.KeyPrefix(types.PendingOrderAccountKeyPrefix), types.PendingOrderAccountKey(req.Address)...),
+	)
+
+	// the index is keyed by the store key of the pending orders
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key []byte, value []byte) error {
+		b := pendingOrderStore.Get(key)
+		if b == nil {
+			return fmt.Errorf("pending order of %s not found", req.Address)
+		}
+
+		var pendingOrder types.PendingOrder
+		if err := k.cdc.Unmarshal(b, &pendingOrder); err != nil {
+			return err
+		}
+
+		pendingOrders = append(pendingOrders, withPendingStatus(ctx, pendingOrder))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingOrdersByAccountResponse{PendingOrder: pendingOrders, Pagination: pageRes}, nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/testutil/nullify"
+	"interchange-nel/x/dex/types"
+)
+
+func TestPendingOrdersQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	now := time.Unix(1_000_000, 0)
+	ctx = ctx.WithBlockTime(now)
+	wctx := sdk.WrapSDKContext(ctx)
+	list := setPendingOrders(keeper, ctx, []types.PendingOrder{
+		pendingOrder(MockAccount("0"), "channel-0", 1, uint64(now.Add(time.Minute).UnixNano())),
+		pendingOrder(MockAccount("0"), "channel-0", 2, uint64(now.UnixNano())),
+		pendingOrder(MockAccount("1"), "channel-0", 3, 0),
+	})
+
+	// the orders whose timeout is reached are timed out
+	expected := []types.PendingOrder{list[0], list[1], list[2]}
+	expected[1].Status = types.PendingTimedOut
+
+	response, err := keeper.PendingOrders(wctx, &types.QueryPendingOrdersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(expected), nullify.Fill(response.PendingOrder))
+
+	response, err = keeper.PendingOrders(wctx, &types.QueryPendingOrdersRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(expected[:2]), nullify.Fill(response.PendingOrder))
+	require.Equal(t, uint64(3), response.Pagination.Total)
+
+	_, err = keeper.PendingOrders(wctx, nil)
+	require.Error(t, err)
+}
+
+func TestPendingOrdersByAccountQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	list := setPendingOrders(keeper, ctx, []types.PendingOrder{
+		pendingOrder(MockAccount("0"), "channel-0", 1, 0),
+		pendingOrder(MockAccount("1"), "channel-0", 2, 0),
+		pendingOrder(MockAccount("0"), "channel-1", 1, 0),
+	})
+
+	response, err := keeper.PendingOrdersByAccount(wctx, &types.QueryPendingOrdersByAccountRequest{Address: MockAccount("0")})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.PendingOrder{list[0], list[2]}), nullify.Fill(response.PendingOrder))
+
+	// acknowledged orders are no longer listed
+	keeper.RemovePendingOrder(ctx, "dex", "channel-0", 1)
+	response, err = keeper.PendingOrdersByAccount(wctx, &types.QueryPendingOrdersByAccountRequest{Address: MockAccount("0")})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.PendingOrder{list[2]}), nullify.Fill(response.PendingOrder))
+
+	response, err = keeper.PendingOrdersByAccount(wctx, &types.QueryPendingOrdersByAccountRequest{Address: MockAccount("2")})
+	require.NoError(t, err)
+	require.Empty(t, response.PendingOrder)
+
+	_, err = keeper.PendingOrdersByAccount(wctx, nil)
+	require.Error(t, err)
+}
@@ -27,6 +27,7 @@ func (k Keeper) SellOrderBookAll(c context.Context, req *types.QueryAllSellOrder
 		if err := k.cdc.Unmarshal(value, &sellOrderBook); err != nil {
 			return err
 		}
+		k.LoadOrders(ctx, sellOrderBook.Index, types.SellSide, sellOrderBook.Book)
 
 		sellOrderBooks = append(sellOrderBooks, sellOrderBook)
 		return nil
@@ -52,6 +53,7 @@ func (k Keeper) SellOrderBook(c context.Context, req *types.QueryGetSellOrderBoo
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
+	k.LoadOrders(ctx, val.Index, types.SellSide, val.Book)
 
 	return &types.QueryGetSellOrderBookResponse{SellOrderBook: val}, nil
 }
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/mem"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange-nel/x/dex/types"
+)
+
+func (k Keeper) AllTickers(c context.Context, req *types.QueryAllTickersRequest) (*types.QueryAllTickersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tickers []types.Ticker
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the pairs are the union of the local books, paginated from a transient store of their indexes
+	pairStore := mem.NewStore()
+	for _, buyOrderBook := range k.GetAllBuyOrderBook(ctx) {
+		pairStore.Set([]byte(buyOrderBook.Index), []byte{})
+	}
+	for _, sellOrderBook := range k.GetAllSellOrderBook(ctx) {
+		pairStore.Set([]byte(sellOrderBook.Index), []byte{})
+	}
+
+	pageRes, err := query.Paginate(pairStore, req.Pagination, func(key []byte, value []byte) error {
+		ticker, _ := k.GetTicker(ctx, string(key))
+
+		tickers = append(tickers, ticker)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTickersResponse{Ticker: tickers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Ticker(c context.Context, req *types.QueryGetTickerRequest) (*types.QueryGetTickerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetTicker(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTickerResponse{Ticker: val}, nil
+}
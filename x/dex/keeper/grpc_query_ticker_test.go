@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func emptyTicker(index string) types.Ticker {
+	return types.Ticker{
+		Index:     index,
+		BestBid:   sdk.ZeroDec(),
+		BestAsk:   sdk.ZeroDec(),
+		Spread:    sdk.ZeroDec(),
+		MidPrice:  sdk.ZeroDec(),
+		LastPrice: sdk.ZeroDec(),
+	}
+}
+
+func TestTickerQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	buyOrderBook := types.NewBuyOrderBook("foo", "bar")
+	buyOrderBook.Index = testPairIndex
+	keeper.SetBuyOrderBook(ctx, buyOrderBook)
+	setOrders(keeper, ctx, types.BuySide, depthBuyBook)
+
+	bidsOnly := emptyTicker(testPairIndex)
+	bidsOnly.BestBid = sdk.NewDecWithPrec(205, 1)
+	request := &types.QueryGetTickerRequest{Index: testPairIndex}
+	response, err := keeper.Ticker(wctx, request)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetTickerResponse{Ticker: bidsOnly}, response)
+
+	// both sides and the last trade of the pair
+	setOrders(keeper, ctx, types.SellSide, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(22)},
+	})
+	keeper.SetLastPrice(ctx, types.LastPrice{PairIndex: testPairIndex, Price: sdk.NewDec(21), Timestamp: 10})
+	response, err = keeper.Ticker(wctx, request)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetTickerResponse{Ticker: types.Ticker{
+		Index:     testPairIndex,
+		BestBid:   sdk.NewDecWithPrec(205, 1),
+		BestAsk:   sdk.NewDec(22),
+		Spread:    sdk.NewDecWithPrec(15, 1),
+		MidPrice:  sdk.NewDecWithPrec(2125, 2),
+		LastPrice: sdk.NewDec(21),
+		Timestamp: 10,
+	}}, response)
+
+	_, err = keeper.Ticker(wctx, &types.QueryGetTickerRequest{Index: "foo"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	_, err = keeper.Ticker(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestAllTickersQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// the pairs of both local book stores are listed once
+	var tickers []types.Ticker
+	for i := 0; i < 5; i++ {
+		index := strconv.Itoa(i)
+		if i%2 == 0 {
+			keeper.SetBuyOrderBook(ctx, types.BuyOrderBook{Index: index})
+		} else {
+			keeper.SetSellOrderBook(ctx, types.SellOrderBook{Index: index})
+		}
+		tickers = append(tickers, emptyTicker(index))
+	}
+	keeper.SetSellOrderBook(ctx, types.SellOrderBook{Index: "0"})
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllTickersRequest {
+		return &types.QueryAllTickersRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(tickers); i += step {
+			resp, err := keeper.AllTickers(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Ticker), step)
+			require.Subset(t, tickers, resp.Ticker)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(tickers); i += step {
+			resp, err := keeper.AllTickers(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Ticker), step)
+			require.Subset(t, tickers, resp.Ticker)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.AllTickers(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(tickers), int(resp.Pagination.Total))
+		require.ElementsMatch(t, tickers, resp.Ticker)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AllTickers(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"interchange-nel/x/dex/types"
+)
+
+func (k Keeper) Trades(c context.Context, req *types.QueryTradesRequest) (*types.QueryTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pairStore := prefix.NewStore(store, append(types.KeyPrefix(types.TradePairKeyPrefix), types.TradePairKey(req.PairIndex)...))
+
+	trades, pageRes, err := k.paginateTradeIndex(ctx, pairStore, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTradesResponse{Trade: trades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TradesByAccount(c context.Context, req *types.QueryTradesByAccountRequest) (*types.QueryTradesByAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	accountStore := prefix.NewStore(store, append(types.KeyPrefix(types.TradeAccountKeyPrefix), types.TradeAccountKey(req.Address)...))
+
+	trades, pageRes, err := k.paginateTradeIndex(ctx, accountStore, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTradesByAccountResponse{Trade: trades, Pagination: pageRes}, nil
+}
+
+// paginateTradeIndex paginates a trade index keyed by trade ID and returns the indexed trades
+func (k Keeper) paginateTradeIndex(
+	ctx sdk.Context,
+	indexStore prefix.Store,
+	pagination *query.PageRequest,
+) ([]types.Trade, *query.PageResponse, error) {
+	var trades []types.Trade
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, value []byte) error {
+		id := sdk.BigEndianToUint64(key)
+		trade, found := k.GetTrade(ctx, id)
+		if !found {
+			return fmt.Errorf("trade %d not found", id)
+		}
+
+		trades = append(trades, trade)
+		return nil
+	})
+
+	return trades, pageRes, err
+}
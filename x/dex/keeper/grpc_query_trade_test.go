@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/testutil/nullify"
+	"interchange-nel/x/dex/types"
+)
+
+func TestTradesQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	otherPairIndex := types.OrderBookIndex("dex", "channel-1", "foo", "bar")
+	recordTestTrades(keeper, ctx, testPairIndex, MockAccount("2"), 100)
+	recordTestTrades(keeper, ctx, otherPairIndex, MockAccount("2"), 200)
+	recordTestTrades(keeper, ctx, testPairIndex, MockAccount("3"), 300)
+	trades := keeper.GetAllTrade(ctx)
+
+	response, err := keeper.Trades(wctx, &types.QueryTradesRequest{PairIndex: testPairIndex})
+	require.NoError(t, err)
+	require.Equal(t,
+		nullify.Fill([]types.Trade{trades[0], trades[1], trades[4], trades[5]}),
+		nullify.Fill(response.Trade),
+	)
+
+	// paginated oldest first
+	response, err = keeper.Trades(wctx, &types.QueryTradesRequest{
+		PairIndex:  testPairIndex,
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(trades[:2]), nullify.Fill(response.Trade[:2]))
+	require.Len(t, response.Trade, 3)
+	require.Equal(t, uint64(4), response.Pagination.Total)
+
+	response, err = keeper.Trades(wctx, &types.QueryTradesRequest{PairIndex: "unknown"})
+	require.NoError(t, err)
+	require.Empty(t, response.Trade)
+
+	_, err = keeper.Trades(wctx, nil)
+	require.Error(t, err)
+}
+
+func TestTradesByAccountQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	recordTestTrades(keeper, ctx, testPairIndex, MockAccount("2"), 100)
+	recordTestTrades(keeper, ctx, testPairIndex, MockAccount("3"), 200)
+	trades := keeper.GetAllTrade(ctx)
+
+	for _, tc := range []struct {
+		desc     string
+		address  string
+		expected []types.Trade
+	}{
+		{
+			desc:     "Maker",
+			address:  MockAccount("0"),
+			expected: []types.Trade{trades[0], trades[2]},
+		},
+		{
+			desc:     "Taker",
+			address:  MockAccount("3"),
+			expected: []types.Trade{trades[2], trades[3]},
+		},
+		{
+			desc:    "NoTrade",
+			address: MockAccount("4"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.TradesByAccount(wctx, &types.QueryTradesByAccountRequest{Address: tc.address})
+			require.NoError(t, err)
+			require.Equal(t, nullify.Fill(tc.expected), nullify.Fill(response.Trade))
+		})
+	}
+
+	_, err := keeper.TradesByAccount(wctx, nil)
+	require.Error(t, err)
+}
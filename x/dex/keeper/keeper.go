@@ -20,7 +20,9 @@ type (
 		memKey     sdk.StoreKey
 		paramstore paramtypes.Subspace
 
-		bankKeeper types.BankKeeper
+		channelKeeper types.ChannelKeeper
+		bankKeeper    types.BankKeeper
+		distrKeeper   types.DistrKeeper
 	}
 )
 
@@ -29,10 +31,11 @@ func NewKeeper(
 	storeKey,
 	memKey sdk.StoreKey,
 	ps paramtypes.Subspace,
-	channelKeeper cosmosibckeeper.ChannelKeeper,
+	channelKeeper types.ChannelKeeper,
 	portKeeper cosmosibckeeper.PortKeeper,
 	scopedKeeper cosmosibckeeper.ScopedKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -47,11 +50,13 @@ func NewKeeper(
 			portKeeper,
 			scopedKeeper,
 		),
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
-		bankKeeper: bankKeeper,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		memKey:        memKey,
+		paramstore:    ps,
+		channelKeeper: channelKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 	}
 }
 
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetLastPrice set a specific lastPrice in the store from its index
+func (k Keeper) SetLastPrice(ctx sdk.Context, lastPrice types.LastPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastPriceKeyPrefix))
+	b := k.cdc.MustMarshal(&lastPrice)
+	store.Set(types.LastPriceKey(
+		lastPrice.PairIndex,
+	), b)
+}
+
+// GetLastPrice returns a lastPrice from its index
+func (k Keeper) GetLastPrice(
+	ctx sdk.Context,
+	pairIndex string,
+) (val types.LastPrice, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastPriceKeyPrefix))
+
+	b := store.Get(types.LastPriceKey(
+		pairIndex,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllLastPrice returns all lastPrice
+func (k Keeper) GetAllLastPrice(ctx sdk.Context) (list []types.LastPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LastPriceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LastPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordLastPrice records the price of the last match of a fill as the last price of the
+// pair
+func (k Keeper) recordLastPrice(ctx sdk.Context, pairIndex string, liquidated []types.Order) {
+	if len(liquidated) == 0 {
+		return
+	}
+
+	k.SetLastPrice(ctx, types.LastPrice{
+		PairIndex: pairIndex,
+		Price:     liquidated[len(liquidated)-1].Price,
+		Timestamp: uint64(ctx.BlockTime().UnixNano()),
+	})
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"interchange-nel/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pairRules returns the trading rules of the book of one side of a pair, no rule if the book does not
+// exist
+func (k Keeper) pairRules(ctx sdk.Context, pairIndex string, side types.OrderSide) types.PairRules {
+	if side == types.BuySide {
+		book, _ := k.GetBuyOrderBook(ctx, pairIndex)
+		return book.Rules
+	}
+
+	book, _ := k.GetSellOrderBook(ctx, pairIndex)
+	return book.Rules
+}
+
+// pairMatcher returns the matcher of the book of one side of a pair, price-time priority if the book
+// does not exist
+func (k Keeper) pairMatcher(ctx sdk.Context, pairIndex string, side types.OrderSide) types.Matcher {
+	return k.pairRules(ctx, pairIndex, side).Matcher()
+}
+
+// bestLevel returns the orders of the best price level of one side of a pair in time priority, if the
+// incoming order crosses it. The orders whose expiry is reached are skipped until they are pruned. Unless
+// the whole level is needed, the level is only read until its orders cover the amount of the incoming
+// order, as matching in time priority never reaches the orders behind
+func (k Keeper) bestLevel(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	incoming types.Order,
+	wholeLevel bool,
+) (level []types.Order) {
+	iterator := k.OrderIterator(ctx, pairIndex, restingSide)
+	defer iterator.Close()
+
+	covered := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var resting types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &resting)
+		if !crosses(restingSide, incoming, resting) {
+			break
+		}
+		if isExpired(ctx, resting) {
+			continue
+		}
+		if len(level) > 0 && !resting.Price.Equal(level[0].Price) {
+			break
+		}
+		if !wholeLevel && types.IsSelfTrade(incoming, resting) {
+			break
+		}
+
+		level = append(level, resting)
+		covered = covered.Add(resting.Amount)
+		if !wholeLevel && covered.GTE(incoming.Amount) {
+			break
+		}
+	}
+
+	return level
+}
+
+// liquidateLevel fills an incoming order against the best price level of one side of a pair, its amount
+// being allocated among the orders of the level by the matcher. The level stops before the first order
+// of the incoming creator, left to self-trade prevention. It returns the incoming order left to match
+// and the liquidated part of each resting order
+func (k Keeper) liquidateLevel(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	incoming types.Order,
+	matcher types.Matcher,
+) (remaining types.Order, liquidated []types.Order, match bool) {
+	level := k.bestLevel(ctx, pairIndex, restingSide, incoming, matcher.SharesLevel())
+	for i, resting := range level {
+		if types.IsSelfTrade(incoming, resting) {
+			level = level[:i]
+			break
+		}
+	}
+	if len(level) == 0 {
+		return incoming, nil, false
+	}
+
+	remaining = incoming
+	for i, allocation := range matcher.Allocate(level, incoming.Amount) {
+		if !allocation.IsPositive() {
+			continue
+		}
+		resting := level[i]
+
+		liquidation := resting
+		liquidation.Amount = allocation
+		liquidated = append(liquidated, liquidation)
+		if allocation.Equal(remaining.Amount) {
+			remaining.Amount = sdk.ZeroInt()
+		} else {
+			remaining.Amount = remaining.Amount.Sub(allocation)
+		}
+
+		// remove the resting order if it has been entirely liquidated, iceberg orders are refilled from
+		// their reserve
+		resting.Amount = resting.Amount.Sub(allocation)
+		if resting.Amount.IsZero() {
+			k.RemoveOrder(ctx, pairIndex, restingSide, resting.Price, resting.TimePriority())
+			k.refillIcebergOrder(ctx, pairIndex, restingSide, resting)
+		} else {
+			k.SetOrder(ctx, pairIndex, restingSide, resting)
+		}
+	}
+
+	return remaining, liquidated, len(liquidated) > 0
+}
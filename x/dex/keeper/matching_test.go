@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+var proRataSellBook = []types.Order{
+	{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(100), Price: sdk.NewDec(10)},
+	{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(300), Price: sdk.NewDec(10)},
+	{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(50), Price: sdk.NewDec(12)},
+}
+
+func setProRataSellBook(k *keeper.Keeper, ctx sdk.Context) {
+	book := types.NewSellOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	book.Rules.MatchingAlgorithm = types.ProRata
+	k.SetSellOrderBook(ctx, book)
+	setOrders(k, ctx, types.SellSide, proRataSellBook)
+}
+
+func TestFillBuyOrderProRata(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setProRataSellBook(k, ctx)
+
+	// the asks at the best price share the fill in proportion to their amounts
+	inputOrder := types.Order{Creator: MockAccount("10"), Amount: sdk.NewInt(200), Price: sdk.NewDec(12)}
+	remaining, liquidated, purchase, _, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+	require.True(t, filled)
+	require.True(t, remaining.Amount.IsZero())
+	require.Equal(t, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(10)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(150), Price: sdk.NewDec(10)},
+	}, liquidated)
+	require.True(t, sdk.NewInt(200).Equal(purchase))
+	require.Equal(t, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(10)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(150), Price: sdk.NewDec(10)},
+		proRataSellBook[2],
+	}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+
+	// an exhausted level moves the fill to the next one
+	inputOrder.Amount = sdk.NewInt(220)
+	remaining, liquidated, _, _, filled = k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+	require.True(t, filled)
+	require.True(t, remaining.Amount.IsZero())
+	require.Len(t, liquidated, 3)
+	require.Equal(t, []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(12)},
+	}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestFillBuyOrderProRataSelfTrade(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setProRataSellBook(k, ctx)
+
+	// the ask of the buyer is cancelled before sharing the level, even if older orders could fill it
+	inputOrder := types.Order{Creator: MockAccount("1"), Amount: sdk.NewInt(50), Price: sdk.NewDec(10)}
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelIncoming))
+	require.True(t, k.CanFillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting))
+
+	remaining, liquidated, _, selfTrade, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+	require.True(t, filled)
+	require.True(t, remaining.Amount.IsZero())
+	require.Equal(t, []types.Order{proRataSellBook[1]}, selfTrade.Resting)
+	require.Equal(t, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(10)},
+	}, liquidated)
+}
+
+func TestFillBuyOrderPriceTimeReadsLevelHead(t *testing.T) {
+	fillGas := func(levelSize int) sdk.Gas {
+		k, ctx := keepertest.DexKeeper(t)
+		var level []types.Order
+		for i := 0; i < levelSize; i++ {
+			level = append(level, types.Order{Id: int32(i), Creator: MockAccount("0"), Amount: sdk.NewInt(10), Price: sdk.NewDec(10)})
+		}
+		setOrders(k, ctx, types.SellSide, level)
+
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		inputOrder := types.Order{Creator: MockAccount("10"), Amount: sdk.NewInt(15), Price: sdk.NewDec(10)}
+		_, liquidated, _, _, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+		require.True(t, filled)
+		require.Len(t, liquidated, 2)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// the orders behind the head of the level are not read
+	require.Equal(t, fillGas(3), fillGas(200))
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	v2 "interchange-nel/x/dex/migrations/v2"
+	"interchange-nel/x/dex/types"
+)
+
+// Migrator migrates the store of the module between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3. The orders stored in their book with
+// int32 amounts and prices are moved to the order store with sdk.Int amounts and sdk.Dec prices and
+// indexed by ID and creator, and the params introduced since are set to their defaults
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, side := range []types.OrderSide{types.SellSide, types.BuySide} {
+		books, err := m.legacyOrderBooks(ctx, side)
+		if err != nil {
+			return err
+		}
+
+		for _, legacy := range books {
+			m.migrateOrderBook(ctx, side, legacy)
+		}
+	}
+
+	m.keeper.SetParams(ctx, types.DefaultParams())
+
+	return nil
+}
+
+// legacyOrderBooks decodes the order books of one side stored with the layout of consensus version 2
+func (m Migrator) legacyOrderBooks(ctx sdk.Context, side types.OrderSide) (list []v2.PairOrderBook, err error) {
+	keyPrefix := types.SellOrderBookKeyPrefix
+	if side == types.BuySide {
+		keyPrefix = types.BuyOrderBookKeyPrefix
+	}
+
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val v2.PairOrderBook
+		if err := proto.Unmarshal(iterator.Value(), &val); err != nil {
+			return nil, err
+		}
+		list = append(list, val)
+	}
+
+	return list, nil
+}
+
+// migrateOrderBook stores the book of one side of a pair without its orders and each of its orders in
+// the order store
+func (m Migrator) migrateOrderBook(ctx sdk.Context, side types.OrderSide, legacy v2.PairOrderBook) {
+	book := types.NewOrderBook()
+	var orders []types.Order
+	if legacy.Book != nil {
+		book.IdCount = legacy.Book.IdCount
+		for _, order := range legacy.Book.Orders {
+			orders = append(orders, types.Order{
+				Id:      order.Id,
+				Creator: order.Creator,
+				Amount:  sdk.NewInt(int64(order.Amount)),
+				Price:   sdk.NewDec(int64(order.Price)),
+			})
+		}
+	}
+
+	if side == types.BuySide {
+		m.keeper.SetBuyOrderBook(ctx, types.BuyOrderBook{
+			Index:       legacy.Index,
+			AmountDenom: legacy.AmountDenom,
+			PriceDenom:  legacy.PriceDenom,
+			Book:        &book,
+		})
+	} else {
+		m.keeper.SetSellOrderBook(ctx, types.SellOrderBook{
+			Index:       legacy.Index,
+			AmountDenom: legacy.AmountDenom,
+			PriceDenom:  legacy.PriceDenom,
+			Book:        &book,
+		})
+	}
+
+	for _, order := range orders {
+		m.keeper.SetOrder(ctx, legacy.Index, side, order)
+	}
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	v2 "interchange-nel/x/dex/migrations/v2"
+	"interchange-nel/x/dex/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx, storeKey := keepertest.DexKeeperWithStoreKey(t)
+
+	// the books of version 2 store their orders with int32 amounts and prices
+	setLegacyBook := func(keyPrefix string, key []byte, book v2.PairOrderBook) {
+		b, err := proto.Marshal(&book)
+		require.NoError(t, err)
+		store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(keyPrefix))
+		store.Set(key, b)
+	}
+	setLegacyBook(types.SellOrderBookKeyPrefix, types.SellOrderBookKey(testPairIndex), v2.PairOrderBook{
+		Index:       testPairIndex,
+		AmountDenom: "foo",
+		PriceDenom:  "bar",
+		Book: &v2.OrderBook{
+			IdCount: 3,
+			Orders: []*v2.Order{
+				{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
+				{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
+				{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
+			},
+		},
+	})
+	setLegacyBook(types.BuyOrderBookKeyPrefix, types.BuyOrderBookKey(testPairIndex), v2.PairOrderBook{
+		Index:       testPairIndex,
+		AmountDenom: "foo",
+		PriceDenom:  "bar",
+		Book: &v2.OrderBook{
+			IdCount: 2,
+			Orders: []*v2.Order{
+				{Id: 0, Creator: MockAccount("0"), Amount: 10, Price: 10},
+				{Id: 1, Creator: MockAccount("1"), Amount: 20, Price: 10},
+			},
+		},
+	})
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate2to3(ctx))
+
+	// the orders are moved to the order store in price-time priority
+	sellOrderBook, found := k.GetSellOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.Equal(t, "foo", sellOrderBook.AmountDenom)
+	require.Equal(t, int32(3), sellOrderBook.Book.IdCount)
+	require.Empty(t, sellOrderBook.Book.Orders)
+	require.Equal(t, []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+	}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+
+	buyOrderBook, found := k.GetBuyOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.Equal(t, int32(2), buyOrderBook.Book.IdCount)
+	require.Equal(t, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(10), Price: sdk.NewDec(10)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(20), Price: sdk.NewDec(10)},
+	}, k.GetAllOrder(ctx, testPairIndex, types.BuySide))
+
+	// and indexed by ID and creator
+	order, err := k.GetOrderFromID(ctx, testPairIndex, types.SellSide, 1)
+	require.NoError(t, err)
+	require.True(t, sdk.NewInt(200).Equal(order.Amount))
+	require.Len(t, k.GetAllOrderLocationByCreator(ctx, MockAccount("0")), 2)
+
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
@@ -22,19 +22,19 @@ func (k Keeper) SafeBurn(
 	channel string,
 	sender sdk.AccAddress,
 	denom string,
-	amount int32,
+	amount sdk.Int,
 ) error {
 	if isIBCToken(denom) {
 		// burn the tokens (vouchers)
 		if err := k.BurnTokens(
-			ctx, sender, sdk.NewCoin(denom, sdk.NewInt(int64(amount))),
+			ctx, sender, sdk.NewCoin(denom, amount),
 		); err != nil {
 			return err
 		}
 	} else {
 		// lock the tokens
 		if err := k.LockTokens(
-			ctx, port, channel, sender, sdk.NewCoin(denom, sdk.NewInt(int64(amount))),
+			ctx, port, channel, sender, sdk.NewCoin(denom, amount),
 		); err != nil {
 			return err
 		}
@@ -87,19 +87,19 @@ func (k Keeper) SafeMint(
 	channel string,
 	receiver sdk.AccAddress,
 	denom string,
-	amount int32,
+	amount sdk.Int,
 ) error {
 	if isIBCToken(denom) {
 		// mint IBC tokens
 		if err := k.MintTokens(
-			ctx, receiver, sdk.NewCoin(denom, sdk.NewInt(int64(amount))),
+			ctx, receiver, sdk.NewCoin(denom, amount),
 		); err != nil {
 			return err
 		}
 	} else {
 		// unlock native tokens
 		if err := k.UnlockTokens(
-			ctx, port, channel, receiver, sdk.NewCoin(denom, sdk.NewInt(int64(amount))),
+			ctx, port, channel, receiver, sdk.NewCoin(denom, amount),
 		); err != nil {
 			return err
 		}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"interchange-nel/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AmendOrder(
+	goCtx context.Context,
+	msg *types.MsgAmendOrder,
+) (*types.MsgAmendOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// retrieve the book
+	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, msg.AmountDenom, msg.PriceDenom)
+	side := msg.GetOrderSide()
+	var buyOrderBook types.BuyOrderBook
+	var sellOrderBook types.SellOrderBook
+	var rules types.PairRules
+	var found, halted bool
+	if side == types.BuySide {
+		buyOrderBook, found = k.GetBuyOrderBook(ctx, pairIndex)
+		rules, halted = buyOrderBook.Rules, buyOrderBook.Halted
+	} else {
+		sellOrderBook, found = k.GetSellOrderBook(ctx, pairIndex)
+		rules, halted = sellOrderBook.Rules, sellOrderBook.Halted
+	}
+	if !found {
+		return &types.MsgAmendOrderResponse{}, errors.New("The pair doesn't exist")
+	}
+
+	// the orders of a halted pair can only be cancelled
+	if halted {
+		return &types.MsgAmendOrderResponse{}, types.ErrPairHalted
+	}
+
+	// check order creator
+	order, err := k.GetOrderFromID(ctx, pairIndex, side, msg.OrderID)
+	if err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+
+	if order.Creator != msg.Creator {
+		return &types.MsgAmendOrderResponse{}, errors.New("Amender must be creator")
+	}
+
+	// the amount can only be reduced
+	if msg.Amount.GT(order.Amount) {
+		return &types.MsgAmendOrderResponse{}, types.ErrAmendAmount
+	}
+	if msg.Amount.Equal(order.Amount) && msg.Price.Equal(order.Price) {
+		return &types.MsgAmendOrderResponse{}, types.ErrAmendNoChange
+	}
+
+	// the amended order must follow the trading rules of the pair
+	if err := rules.ValidateOrder(msg.Amount, msg.Price); err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+	if err := types.ValidateQuote(msg.Amount, msg.Price); err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+
+	// a reduced amount keeps the time priority of the order, a new price re-inserts it behind the
+	// orders already at that price
+	amended := order
+	amended.Amount = msg.Amount
+	if !msg.Price.Equal(order.Price) {
+		book := sellOrderBook.Book
+		if side == types.BuySide {
+			book = buyOrderBook.Book
+		}
+
+		reinserted, err := book.NewOrder(k.GetParams(ctx), order.Creator, msg.Amount, msg.Price)
+		if err != nil {
+			return &types.MsgAmendOrderResponse{}, err
+		}
+		reinserted.Expiry = order.Expiry
+		reinserted.OriginalAmount = order.OriginalAmount
+		amended = reinserted
+
+		// save the next order ID of the book
+		if side == types.BuySide {
+			k.SetBuyOrderBook(ctx, buyOrderBook)
+		} else {
+			k.SetSellOrderBook(ctx, sellOrderBook)
+		}
+	}
+
+	if err := k.Keeper.AmendOrder(
+		ctx,
+		msg.Port,
+		msg.Channel,
+		msg.AmountDenom,
+		msg.PriceDenom,
+		side,
+		order,
+		amended,
+	); err != nil {
+		return &types.MsgAmendOrderResponse{}, err
+	}
+
+	return &types.MsgAmendOrderResponse{OrderID: amended.Id}, nil
+}
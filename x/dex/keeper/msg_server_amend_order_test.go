@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func TestMsgServerAmendOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	creator := GenAddress()
+	originalAmount := sdk.NewInt(12)
+
+	book := types.NewBuyOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	list := []types.Order{
+		{Id: 0, Creator: creator, Amount: sdk.NewInt(10), Price: sdk.NewDecWithPrec(15, 1), OriginalAmount: &originalAmount},
+		{Id: 1, Creator: GenAddress(), Amount: sdk.NewInt(20), Price: sdk.NewDecWithPrec(155, 2)},
+	}
+	book.Book.IdCount = 2
+	k.SetBuyOrderBook(ctx, book)
+	setOrders(k, ctx, types.BuySide, list)
+
+	amend := func(creator string, id int32, amount sdk.Int, price sdk.Dec) (*types.MsgAmendOrderResponse, error) {
+		return srv.AmendOrder(sdk.WrapSDKContext(ctx), types.NewMsgAmendOrder(
+			creator, "dex", "channel-0", "foo", "bar", types.BuySide, id, amount, price,
+		))
+	}
+
+	_, err := amend(creator, 2, sdk.NewInt(10), sdk.NewDecWithPrec(15, 1))
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+	_, err = amend(GenAddress(), 0, sdk.NewInt(10), sdk.NewDecWithPrec(155, 2))
+	require.Error(t, err)
+	_, err = amend(creator, 0, sdk.NewInt(11), sdk.NewDecWithPrec(15, 1))
+	require.ErrorIs(t, err, types.ErrAmendAmount)
+	_, err = amend(creator, 0, sdk.NewInt(10), sdk.NewDecWithPrec(15, 1))
+	require.ErrorIs(t, err, types.ErrAmendNoChange)
+	_, err = amend(creator, 0, sdk.NewInt(1), sdk.NewDecWithPrec(5, 1))
+	require.ErrorIs(t, err, types.ErrZeroQuote)
+	_, err = srv.AmendOrder(sdk.WrapSDKContext(ctx), types.NewMsgAmendOrder(
+		creator, "dex", "channel-0", "bar", "foo", types.BuySide, 0, sdk.NewInt(10), sdk.NewDec(2),
+	))
+	require.Error(t, err)
+
+	// the amended order must follow the trading rules of the pair
+	require.NoError(t, k.SetPairRules(ctx, testPairIndex, types.PairRules{TickSize: sdk.NewDecWithPrec(5, 2)}))
+	_, err = amend(creator, 0, sdk.NewInt(10), sdk.NewDecWithPrec(153, 2))
+	require.ErrorIs(t, err, types.ErrTickSize)
+	require.NoError(t, k.SetPairRules(ctx, testPairIndex, types.PairRules{}))
+
+	// a new price re-inserts the order behind the orders at that price, the escrowed quote of 15 is
+	// unchanged so nothing is settled
+	res, err := amend(creator, 0, sdk.NewInt(10), sdk.NewDecWithPrec(155, 2))
+	require.NoError(t, err)
+	require.Equal(t, int32(2), res.OrderID)
+
+	_, err = k.GetOrderFromID(ctx, testPairIndex, types.BuySide, 0)
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+	amended, err := k.GetOrderFromID(ctx, testPairIndex, types.BuySide, 2)
+	require.NoError(t, err)
+	require.Equal(t, list[0].OriginalAmount, amended.OriginalAmount)
+	require.Equal(t, []types.Order{list[1], amended}, k.GetAllOrder(ctx, testPairIndex, types.BuySide))
+
+	book, found := k.GetBuyOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.Equal(t, int32(3), book.Book.IdCount)
+}
+
+func TestAmendOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	list := []types.Order{
+		{Id: 0, Creator: GenAddress(), Amount: sdk.NewInt(10), Price: sdk.NewDec(20)},
+		{Id: 1, Creator: GenAddress(), Amount: sdk.NewInt(20), Price: sdk.NewDec(20)},
+	}
+	setOrders(k, ctx, types.SellSide, list)
+
+	// sell orders escrow their amount, a price change settles nothing
+	amended := list[1]
+	amended.Id = 2
+	amended.Price = sdk.NewDec(15)
+	require.NoError(t, k.AmendOrder(ctx, "dex", "channel-0", "foo", "bar", types.SellSide, list[1], amended))
+	require.Equal(t, []types.Order{amended, list[0]}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+	_, found := k.GetOrderLocation(ctx, testPairIndex, types.SellSide, list[1].Id)
+	require.False(t, found)
+}
@@ -18,10 +18,28 @@ func (k msgServer) SendBuyOrder(
 
 	// cannot send a order if the pair doesn't exist
 	pairIndex := types.OrderBookIndex(msg.Port, msg.ChannelID, msg.AmountDenom, msg.PriceDenom)
-	_, found := k.GetBuyOrderBook(ctx, pairIndex)
+	book, found := k.GetBuyOrderBook(ctx, pairIndex)
 	if !found {
 		return &types.MsgSendBuyOrderResponse{}, errors.New("the pair doesn't exist")
 	}
+	if book.Halted {
+		return &types.MsgSendBuyOrderResponse{}, types.ErrPairHalted
+	}
+
+	// check the order against the trading rules of the pair
+	if err := msg.ValidateRules(book.Rules); err != nil {
+		return &types.MsgSendBuyOrderResponse{}, err
+	}
+
+	// check the order against the maximum amount and price of the module
+	if err := msg.ValidateLimits(k.GetParams(ctx)); err != nil {
+		return &types.MsgSendBuyOrderResponse{}, err
+	}
+
+	// orders would be pruned as soon as they are appended to the book
+	if msg.Expiry.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+		return &types.MsgSendBuyOrderResponse{}, types.ErrOrderExpired
+	}
 
 	// lock the token to send
 	sender, err := sdk.AccAddressFromBech32(msg.Creator)
@@ -30,7 +48,14 @@ func (k msgServer) SendBuyOrder(
 	}
 
 	// use SafeBurn to ensure no new native tokens are minted
-	if err := k.SafeBurn(ctx, msg.Port, msg.ChannelID, sender, msg.PriceDenom, msg.Amount*msg.Price); err != nil {
+	if err := k.SafeBurn(
+		ctx,
+		msg.Port,
+		msg.ChannelID,
+		sender,
+		msg.PriceDenom,
+		msg.EscrowAmount(),
+	); err != nil {
 		return &types.MsgSendBuyOrderResponse{}, err
 	}
 
@@ -46,6 +71,29 @@ func (k msgServer) SendBuyOrder(
 	packet.Amount = msg.Amount
 	packet.PriceDenom = msg.PriceDenom
 	packet.Price = msg.Price
+	packet.OrderType = msg.OrderType
+	packet.TimeInForce = msg.TimeInForce
+	packet.Expiry = msg.Expiry
+	packet.PostOnly = msg.PostOnly
+	packet.DisplayAmount = msg.DisplayAmount
+	packet.SelfTradePrevention = msg.SelfTradePrevention
+	packet.MaxQuote = msg.MaxQuote
+
+	// stop orders are kept escrowed on this chain until the last price crosses their stop price
+	if msg.IsStopOrder() {
+		id, err := k.PlaceStopOrder(ctx, types.StopOrder{
+			Creator:   msg.Creator,
+			Port:      msg.Port,
+			ChannelID: msg.ChannelID,
+			StopPrice: msg.StopPrice,
+			Packet:    &types.StopOrder_BuyOrder{BuyOrder: &packet},
+		}, msg.TimeoutTimestamp)
+		if err != nil {
+			return &types.MsgSendBuyOrderResponse{}, err
+		}
+
+		return &types.MsgSendBuyOrderResponse{StopOrderID: id}, nil
+	}
 
 	// Transmit the packet
 	err = k.TransmitBuyOrderPacket(
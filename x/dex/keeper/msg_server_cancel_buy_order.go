@@ -17,13 +17,13 @@ func (k msgServer) CancelBuyOrder(
 
 	// retrieve the book
 	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, msg.AmountDenom, msg.PriceDenom)
-	b, found := k.GetBuyOrderBook(ctx, pairIndex)
+	_, found := k.GetBuyOrderBook(ctx, pairIndex)
 	if !found {
 		return &types.MsgCancelBuyOrderResponse{}, errors.New("The pair doesn't exist")
 	}
 
 	// check order creator
-	order, err := b.Book.GetOrderFromID(msg.OrderID)
+	order, err := k.GetOrderFromID(ctx, pairIndex, types.BuySide, msg.OrderID)
 	if err != nil {
 		return &types.MsgCancelBuyOrderResponse{}, err
 	}
@@ -32,26 +32,19 @@ func (k msgServer) CancelBuyOrder(
 		return &types.MsgCancelBuyOrderResponse{}, errors.New("Canceller must be creator")
 	}
 
-	// remove order
-	if err := b.Book.RemoveOrderFromID(msg.OrderID); err != nil {
-		return &types.MsgCancelBuyOrderResponse{}, err
-	}
-
-	k.SetBuyOrderBook(ctx, b)
+	// remove order, the hidden reserve of iceberg orders is refunded with it
+	k.RemoveOrder(ctx, pairIndex, types.BuySide, order.Price, order.TimePriority())
+	order.Amount = order.Amount.Add(k.takeOrderReserve(ctx, pairIndex, types.BuySide, order.Id))
 
 	// refund buyer with remaining price amount
-	buyer, err := sdk.AccAddressFromBech32(order.Creator)
-	if err != nil {
-		return &types.MsgCancelBuyOrderResponse{}, err
-	}
-
-	if err := k.SafeMint(
+	if err := k.RefundOrder(
 		ctx,
 		msg.Port,
 		msg.Channel,
-		buyer,
+		msg.AmountDenom,
 		msg.PriceDenom,
-		order.Amount*order.Price,
+		types.BuySide,
+		order,
 	); err != nil {
 		return &types.MsgCancelBuyOrderResponse{}, err
 	}
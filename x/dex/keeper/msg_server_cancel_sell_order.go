@@ -17,13 +17,13 @@ func (k msgServer) CancelSellOrder(
 
 	// retrieve the book
 	pairIndex := types.OrderBookIndex(msg.Port, msg.Channel, msg.AmountDenom, msg.PriceDenom)
-	s, found := k.GetSellOrderBook(ctx, pairIndex)
+	_, found := k.GetSellOrderBook(ctx, pairIndex)
 	if !found {
 		return &types.MsgCancelSellOrderResponse{}, errors.New("The pair doesn't exist")
 	}
 
 	// check order creator
-	order, err := s.Book.GetOrderFromID(msg.OrderID)
+	order, err := k.GetOrderFromID(ctx, pairIndex, types.SellSide, msg.OrderID)
 	if err != nil {
 		return &types.MsgCancelSellOrderResponse{}, err
 	}
@@ -32,20 +32,20 @@ func (k msgServer) CancelSellOrder(
 		return &types.MsgCancelSellOrderResponse{}, errors.New("Canceller must be creator")
 	}
 
-	// remove order
-	if err := s.Book.RemoveOrderFromID(msg.OrderID); err != nil {
-		return &types.MsgCancelSellOrderResponse{}, err
-	}
-
-	k.SetSellOrderBook(ctx, s)
+	// remove order, the hidden reserve of iceberg orders is refunded with it
+	k.RemoveOrder(ctx, pairIndex, types.SellSide, order.Price, order.TimePriority())
+	order.Amount = order.Amount.Add(k.takeOrderReserve(ctx, pairIndex, types.SellSide, order.Id))
 
 	// refund seller with remaining amount
-	seller, err := sdk.AccAddressFromBech32(order.Creator)
-	if err != nil {
-		return &types.MsgCancelSellOrderResponse{}, err
-	}
-
-	if err := k.SafeMint(ctx, msg.Port, msg.Channel, seller, msg.AmountDenom, order.Amount); err != nil {
+	if err := k.RefundOrder(
+		ctx,
+		msg.Port,
+		msg.Channel,
+		msg.AmountDenom,
+		msg.PriceDenom,
+		types.SellSide,
+		order,
+	); err != nil {
 		return &types.MsgCancelSellOrderResponse{}, err
 	}
 
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"interchange-nel/x/dex/types"
+)
+
+func (k msgServer) CancelStopOrder(
+	goCtx context.Context,
+	msg *types.MsgCancelStopOrder,
+) (*types.MsgCancelStopOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check order creator
+	stopOrder, found := k.GetStopOrder(ctx, msg.Id)
+	if !found {
+		return &types.MsgCancelStopOrderResponse{}, types.ErrStopOrderNotFound
+	}
+
+	if stopOrder.Creator != msg.Creator {
+		return &types.MsgCancelStopOrderResponse{}, errors.New("Canceller must be creator")
+	}
+
+	// remove order
+	k.RemoveStopOrder(ctx, stopOrder.Id)
+
+	// refund the tokens escrowed at placement
+	if err := k.RefundStopOrder(ctx, stopOrder); err != nil {
+		return &types.MsgCancelStopOrderResponse{}, err
+	}
+
+	return &types.MsgCancelStopOrderResponse{}, nil
+}
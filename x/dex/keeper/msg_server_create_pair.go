@@ -30,6 +30,7 @@ func (k msgServer) SendCreatePair(
 
 	packet.SourceDenom = msg.SourceDenom
 	packet.TargetDenom = msg.TargetDenom
+	packet.Rules = msg.Rules
 
 	// Transmit the packet
 	err := k.TransmitCreatePairPacket(
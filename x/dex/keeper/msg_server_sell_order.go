@@ -18,10 +18,28 @@ func (k msgServer) SendSellOrder(
 
 	// if an order book doesn't exist, throw an error
 	pairIndex := types.OrderBookIndex(msg.Port, msg.ChannelID, msg.AmountDenom, msg.PriceDenom)
-	_, found := k.GetSellOrderBook(ctx, pairIndex)
+	book, found := k.GetSellOrderBook(ctx, pairIndex)
 	if !found {
 		return &types.MsgSendSellOrderResponse{}, errors.New("The pair doesn't exist")
 	}
+	if book.Halted {
+		return &types.MsgSendSellOrderResponse{}, types.ErrPairHalted
+	}
+
+	// check the order against the trading rules of the pair
+	if err := msg.ValidateRules(book.Rules); err != nil {
+		return &types.MsgSendSellOrderResponse{}, err
+	}
+
+	// check the order against the maximum amount and price of the module
+	if err := msg.ValidateLimits(k.GetParams(ctx)); err != nil {
+		return &types.MsgSendSellOrderResponse{}, err
+	}
+
+	// orders would be pruned as soon as they are appended to the book
+	if msg.Expiry.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+		return &types.MsgSendSellOrderResponse{}, types.ErrOrderExpired
+	}
 
 	// get sender's address
 	sender, err := sdk.AccAddressFromBech32(msg.Creator)
@@ -48,6 +66,28 @@ func (k msgServer) SendSellOrder(
 	packet.Amount = msg.Amount
 	packet.PriceDenom = msg.PriceDenom
 	packet.Price = msg.Price
+	packet.OrderType = msg.OrderType
+	packet.TimeInForce = msg.TimeInForce
+	packet.Expiry = msg.Expiry
+	packet.PostOnly = msg.PostOnly
+	packet.DisplayAmount = msg.DisplayAmount
+	packet.SelfTradePrevention = msg.SelfTradePrevention
+
+	// stop orders are kept escrowed on this chain until the last price crosses their stop price
+	if msg.IsStopOrder() {
+		id, err := k.PlaceStopOrder(ctx, types.StopOrder{
+			Creator:   msg.Creator,
+			Port:      msg.Port,
+			ChannelID: msg.ChannelID,
+			StopPrice: msg.StopPrice,
+			Packet:    &types.StopOrder_SellOrder{SellOrder: &packet},
+		}, msg.TimeoutTimestamp)
+		if err != nil {
+			return &types.MsgSendSellOrderResponse{}, err
+		}
+
+		return &types.MsgSendSellOrderResponse{StopOrderID: id}, nil
+	}
 
 	// Transmit the packet
 	err = k.TransmitSellOrderPacket(
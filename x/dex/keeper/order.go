@@ -0,0 +1,376 @@
+package keeper
+
+import (
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetOrder set a specific order on one side of a pair in the store, indexes it by ID and creator and
+// queues its expiry. An order whose price or expiry changes must be removed before being set again
+func (k Keeper) SetOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	order types.Order,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderKeyPrefix))
+	b := k.cdc.MustMarshal(&order)
+	store.Set(types.OrderKey(
+		pairIndex,
+		side,
+		order.Price,
+		order.TimePriority(),
+	), b)
+
+	location := types.OrderLocation{
+		PairIndex: pairIndex,
+		Side:      uint32(side),
+		Price:     order.Price,
+		Id:        order.Id,
+		Sequence:  order.Sequence,
+	}
+	b = k.cdc.MustMarshal(&location)
+
+	idStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderIDIndexKeyPrefix))
+	idStore.Set(types.OrderIDIndexKey(
+		pairIndex,
+		side,
+		order.Id,
+	), b)
+
+	creatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderCreatorIndexKeyPrefix))
+	creatorStore.Set(types.OrderCreatorIndexKey(
+		order.Creator,
+		pairIndex,
+		side,
+		order.Id,
+	), b)
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderExpiryQueueKeyPrefix))
+	for _, key := range types.OrderExpiryQueueKeys(pairIndex, side, order.Id, order.Expiry) {
+		expiryStore.Set(key, b)
+	}
+}
+
+// GetOrder returns an order from its pair, side, price and time priority
+func (k Keeper) GetOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	price sdk.Dec,
+	timePriority int32,
+) (val types.Order, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderKeyPrefix))
+
+	b := store.Get(types.OrderKey(
+		pairIndex,
+		side,
+		price,
+		timePriority,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOrder removes an order from its pair, side, price and time priority, its index entries and its
+// expiry from the store
+func (k Keeper) RemoveOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	price sdk.Dec,
+	timePriority int32,
+) {
+	order, found := k.GetOrder(ctx, pairIndex, side, price, timePriority)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderKeyPrefix))
+	store.Delete(types.OrderKey(
+		pairIndex,
+		side,
+		price,
+		timePriority,
+	))
+
+	idStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderIDIndexKeyPrefix))
+	idStore.Delete(types.OrderIDIndexKey(
+		pairIndex,
+		side,
+		order.Id,
+	))
+
+	creatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderCreatorIndexKeyPrefix))
+	creatorStore.Delete(types.OrderCreatorIndexKey(
+		order.Creator,
+		pairIndex,
+		side,
+		order.Id,
+	))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderExpiryQueueKeyPrefix))
+	for _, key := range types.OrderExpiryQueueKeys(pairIndex, side, order.Id, order.Expiry) {
+		expiryStore.Delete(key)
+	}
+}
+
+// GetOrderLocation returns the location of an order from its pair, side and ID
+func (k Keeper) GetOrderLocation(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	id int32,
+) (val types.OrderLocation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderIDIndexKeyPrefix))
+
+	b := store.Get(types.OrderIDIndexKey(
+		pairIndex,
+		side,
+		id,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllOrderLocationByCreator returns the location of all the orders of a creator, sorted by pair,
+// side and ID
+func (k Keeper) GetAllOrderLocationByCreator(
+	ctx sdk.Context,
+	creator string,
+) (list []types.OrderLocation) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(types.OrderCreatorIndexKeyPrefix), types.OrderCreatorKey(creator)...),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OrderLocation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// OrderIterator returns an iterator over the orders on one side of a pair in price-time priority:
+// lowest ask first on the sell side and highest bid first on the buy side
+func (k Keeper) OrderIterator(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+) sdk.Iterator {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(types.OrderKeyPrefix), types.OrderBookSideKey(pairIndex, side)...),
+	)
+
+	if side == types.BuySide {
+		return sdk.KVStoreReversePrefixIterator(store, []byte{})
+	}
+
+	return sdk.KVStorePrefixIterator(store, []byte{})
+}
+
+// GetBestOrder returns the order with the highest priority on one side of a pair, the orders whose expiry
+// is reached being skipped
+func (k Keeper) GetBestOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+) (val types.Order, found bool) {
+	iterator := k.OrderIterator(ctx, pairIndex, side)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		if !isExpired(ctx, order) {
+			return order, true
+		}
+	}
+
+	return val, false
+}
+
+// GetOrderFromID returns an order on one side of a pair from its ID
+func (k Keeper) GetOrderFromID(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	id int32,
+) (types.Order, error) {
+	location, found := k.GetOrderLocation(ctx, pairIndex, side, id)
+	if !found {
+		return types.Order{}, types.ErrOrderNotFound
+	}
+
+	order, found := k.GetOrder(ctx, pairIndex, side, location.Price, location.TimePriority())
+	if !found {
+		return types.Order{}, types.ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+// GetAllOrder returns all the orders on one side of a pair in price-time priority
+func (k Keeper) GetAllOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+) (list []types.Order) {
+	iterator := k.OrderIterator(ctx, pairIndex, side)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// LoadOrders fills the orders of an order book from the orders stored on one side of a pair, for
+// queries and genesis export
+func (k Keeper) LoadOrders(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	book *types.OrderBook,
+) {
+	if book == nil {
+		return
+	}
+
+	book.Orders = nil
+	for _, order := range k.GetAllOrder(ctx, pairIndex, side) {
+		order := order
+		book.Orders = append(book.Orders, &order)
+	}
+}
+
+// RefundOrder refunds the creator of an order removed from one side of a pair with the tokens escrowed
+// for its remaining amount: the amount for sell orders and its quote for buy orders
+func (k Keeper) RefundOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	amountDenom string,
+	priceDenom string,
+	side types.OrderSide,
+	order types.Order,
+) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return err
+	}
+
+	denom, amount := amountDenom, order.Amount
+	if side == types.BuySide {
+		denom, amount = priceDenom, types.QuoteAmount(order.Amount, order.Price)
+	}
+
+	return k.SafeMint(ctx, port, channel, creator, denom, amount)
+}
+
+// AmendOrder replaces an order of the book by its amended version and settles the difference of escrow
+// with its creator: the excess is refunded through SafeMint and the shortfall is escrowed through SafeBurn
+func (k Keeper) AmendOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	amountDenom string,
+	priceDenom string,
+	side types.OrderSide,
+	order types.Order,
+	amended types.Order,
+) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return err
+	}
+
+	pairIndex := types.OrderBookIndex(port, channel, amountDenom, priceDenom)
+	k.RemoveOrder(ctx, pairIndex, side, order.Price, order.TimePriority())
+	k.SetOrder(ctx, pairIndex, side, amended)
+
+	// the hidden reserve of an iceberg order follows the order
+	hidden := sdk.ZeroInt()
+	if reserve, found := k.GetOrderReserve(ctx, pairIndex, side, order.Id); found {
+		hidden = reserve.Reserve
+		k.RemoveOrderReserve(ctx, pairIndex, side, order.Id)
+		reserve.Id = amended.Id
+		k.SetOrderReserve(ctx, reserve)
+	}
+
+	// sell orders escrow their amount, buy orders the price of their amount
+	denom, escrowed, required := amountDenom, order.Amount, amended.Amount
+	if side == types.BuySide {
+		denom = priceDenom
+		escrowed = types.QuoteAmount(order.Amount, order.Price).Add(types.QuoteAmount(hidden, order.Price))
+		required = types.QuoteAmount(amended.Amount, amended.Price).Add(types.QuoteAmount(hidden, amended.Price))
+	}
+
+	switch {
+	case required.GT(escrowed):
+		return k.SafeBurn(ctx, port, channel, creator, denom, required.Sub(escrowed))
+	case required.LT(escrowed):
+		return k.SafeMint(ctx, port, channel, creator, denom, escrowed.Sub(required))
+	default:
+		return nil
+	}
+}
+
+// DepthLevels aggregates the orders on one side of a pair by price level, best price first. Prices are
+// grouped in buckets when the bucket size is positive and at most limit levels are returned if limit is
+// positive. The hidden reserves of iceberg orders and the orders whose expiry is reached are not included
+func (k Keeper) DepthLevels(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	limit uint32,
+	bucketSize sdk.Dec,
+) (levels []types.DepthLevel) {
+	iterator := k.OrderIterator(ctx, pairIndex, side)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		if isExpired(ctx, order) {
+			continue
+		}
+
+		price := types.DepthBucketPrice(order.Price, bucketSize, side)
+		if last := len(levels) - 1; last >= 0 && levels[last].Price.Equal(price) {
+			levels[last].Amount = levels[last].Amount.Add(order.Amount)
+			levels[last].OrderCount++
+			continue
+		}
+
+		if limit > 0 && len(levels) == int(limit) {
+			break
+		}
+		levels = append(levels, types.DepthLevel{
+			Price:      price,
+			Amount:     order.Amount,
+			OrderCount: 1,
+		})
+	}
+
+	return levels
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"errors"
+
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// expiredOrderEntry is an order whose expiry is reached, with the keys of its entries in the expiry queue
+type expiredOrderEntry struct {
+	location types.OrderLocation
+	keys     [][]byte
+}
+
+// GetExpiredOrderLocations returns the location of at most limit orders whose expiry is reached at the
+// current block, earliest expiry timestamps first then earliest expiry heights
+func (k Keeper) GetExpiredOrderLocations(ctx sdk.Context, limit int) (list []types.OrderLocation) {
+	for _, entry := range k.getExpiredOrderEntries(ctx, limit) {
+		list = append(list, entry.location)
+	}
+
+	return list
+}
+
+func (k Keeper) getExpiredOrderEntries(ctx sdk.Context, limit int) (list []*expiredOrderEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderExpiryQueueKeyPrefix))
+
+	// orders with both an expiry timestamp and height are queued twice
+	seen := make(map[string]*expiredOrderEntry)
+	collect := func(iterator sdk.Iterator) {
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			var val types.OrderLocation
+			k.cdc.MustUnmarshal(iterator.Value(), &val)
+			queueKey := append([]byte{}, iterator.Key()...)
+
+			key := string(types.OrderIDIndexKey(val.PairIndex, types.OrderSide(val.Side), val.Id))
+			if entry, ok := seen[key]; ok {
+				entry.keys = append(entry.keys, queueKey)
+				continue
+			}
+			if len(list) == limit {
+				return
+			}
+
+			entry := &expiredOrderEntry{location: val, keys: [][]byte{queueKey}}
+			seen[key] = entry
+			list = append(list, entry)
+		}
+	}
+
+	collect(store.Iterator(
+		types.OrderExpiryTimestampKey(0),
+		types.OrderExpiryTimestampKey(uint64(ctx.BlockTime().UnixNano())+1),
+	))
+	collect(store.Iterator(
+		types.OrderExpiryHeightKey(0),
+		types.OrderExpiryHeightKey(ctx.BlockHeight()+1),
+	))
+
+	return list
+}
+
+// PruneExpiredOrders removes the orders whose expiry is reached and refunds their creators like a
+// cancellation. The ExpiredOrdersPruneLimit param caps the orders pruned per block, the others are pruned in
+// the next blocks. The orders that cannot be pruned leave the queue rather than being retried in every
+// block, they are no longer matched and can still be cancelled by their creators
+func (k Keeper) PruneExpiredOrders(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderExpiryQueueKeyPrefix))
+	for _, entry := range k.getExpiredOrderEntries(ctx, int(k.ExpiredOrdersPruneLimit(ctx))) {
+		if err := k.pruneExpiredOrder(ctx, entry.location); err != nil {
+			for _, key := range entry.keys {
+				store.Delete(key)
+			}
+			k.Logger(ctx).Error(
+				"cannot prune expired order",
+				"pair", entry.location.PairIndex,
+				"id", entry.location.Id,
+				"error", err,
+			)
+		}
+	}
+}
+
+// isExpired returns whether the expiry of a resting order is reached at the current block, expired
+// orders are no longer matched while they wait to be pruned
+func isExpired(ctx sdk.Context, order types.Order) bool {
+	return order.Expiry.IsExpired(ctx.BlockTime(), ctx.BlockHeight())
+}
+
+func (k Keeper) pruneExpiredOrder(ctx sdk.Context, location types.OrderLocation) error {
+	side := types.OrderSide(location.Side)
+	order, found := k.GetOrder(ctx, location.PairIndex, side, location.Price, location.TimePriority())
+	if !found {
+		return types.ErrOrderNotFound
+	}
+
+	// retrieve the book for the port and channel escrowing the order
+	var port, channel, amountDenom, priceDenom string
+	switch side {
+	case types.BuySide:
+		book, found := k.GetBuyOrderBook(ctx, location.PairIndex)
+		if !found {
+			return errors.New("the pair doesn't exist")
+		}
+		port, channel, amountDenom, priceDenom = book.Port, book.Channel, book.AmountDenom, book.PriceDenom
+	case types.SellSide:
+		book, found := k.GetSellOrderBook(ctx, location.PairIndex)
+		if !found {
+			return errors.New("the pair doesn't exist")
+		}
+		port, channel, amountDenom, priceDenom = book.Port, book.Channel, book.AmountDenom, book.PriceDenom
+	}
+
+	// the order is only removed if its creator can be refunded
+	cacheCtx, writeCache := ctx.CacheContext()
+	k.RemoveOrder(cacheCtx, location.PairIndex, side, order.Price, order.TimePriority())
+	order.Amount = order.Amount.Add(k.takeOrderReserve(cacheCtx, location.PairIndex, side, order.Id))
+	if err := k.RefundOrder(cacheCtx, port, channel, amountDenom, priceDenom, side, order); err != nil {
+		return err
+	}
+	writeCache()
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func TestGetExpiredOrderLocations(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	now := time.Unix(1_000, 0)
+	list := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20), Expiry: types.OrderExpiry{
+			Timestamp: uint64(now.UnixNano()),
+		}},
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15), Expiry: types.OrderExpiry{
+			Height: 10,
+		}},
+		{Id: 3, Creator: MockAccount("3"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15), Expiry: types.OrderExpiry{
+			Timestamp: uint64(now.Add(-time.Second).UnixNano()),
+			Height:    5,
+		}},
+	}
+	setOrders(k, ctx, types.SellSide, list)
+
+	location := func(order types.Order) types.OrderLocation {
+		return types.OrderLocation{
+			PairIndex: testPairIndex,
+			Side:      uint32(types.SellSide),
+			Price:     order.Price,
+			Id:        order.Id,
+		}
+	}
+
+	// nothing expired yet
+	ctx = ctx.WithBlockTime(now.Add(-2 * time.Second)).WithBlockHeight(4)
+	require.Empty(t, k.GetExpiredOrderLocations(ctx, 10))
+
+	// orders expiring on both timestamp and height are returned once
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(10)
+	require.Equal(t, []types.OrderLocation{
+		location(list[3]),
+		location(list[1]),
+		location(list[2]),
+	}, k.GetExpiredOrderLocations(ctx, 10))
+
+	// bounded batches
+	require.Equal(t, []types.OrderLocation{
+		location(list[3]),
+		location(list[1]),
+	}, k.GetExpiredOrderLocations(ctx, 2))
+
+	// removed orders leave the queue
+	k.RemoveOrder(ctx, testPairIndex, types.SellSide, list[3].Price, list[3].Id)
+	require.Equal(t, []types.OrderLocation{
+		location(list[1]),
+		location(list[2]),
+	}, k.GetExpiredOrderLocations(ctx, 10))
+
+	// filled orders leave the queue
+	k.FillBuyOrder(ctx.WithBlockHeight(9), testPairIndex, types.Order{Amount: sdk.NewInt(30), Price: sdk.NewDec(15)}, types.CancelResting)
+	require.Equal(t, []types.OrderLocation{
+		location(list[1]),
+	}, k.GetExpiredOrderLocations(ctx, 10))
+}
+
+func TestExpiredOrdersNotMatched(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	list := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15), Expiry: types.OrderExpiry{
+			Height: 10,
+		}},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+	}
+	setOrders(k, ctx, types.SellSide, list)
+	ctx = ctx.WithBlockHeight(10)
+
+	// the expired ask waiting to be pruned is skipped
+	bestOrder, found := k.GetBestOrder(ctx, testPairIndex, types.SellSide)
+	require.True(t, found)
+	require.Equal(t, list[1], bestOrder)
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, types.Order{Amount: sdk.NewInt(30), Price: sdk.NewDec(15)}, types.CancelResting))
+
+	remaining, liquidated, _, _, _ := k.FillBuyOrder(
+		ctx,
+		testPairIndex,
+		types.Order{Amount: sdk.NewInt(40), Price: sdk.NewDec(20)},
+		types.CancelResting,
+	)
+	require.True(t, remaining.Amount.IsZero())
+	require.Len(t, liquidated, 1)
+	require.Equal(t, list[1].Id, liquidated[0].Id)
+
+	order, err := k.GetOrderFromID(ctx, testPairIndex, types.SellSide, 0)
+	require.NoError(t, err)
+	require.Equal(t, list[0], order)
+}
+
+func TestPruneExpiredOrdersFailure(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	order := types.Order{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15), Expiry: types.OrderExpiry{
+		Timestamp: 1,
+		Height:    10,
+	}}
+	setOrders(k, ctx, types.SellSide, []types.Order{order})
+	ctx = ctx.WithBlockHeight(10)
+
+	// the order of a missing book cannot be refunded, it leaves the queue but stays cancellable
+	k.PruneExpiredOrders(ctx)
+	require.Empty(t, k.GetExpiredOrderLocations(ctx, 10))
+	require.Equal(t, []types.Order{order}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
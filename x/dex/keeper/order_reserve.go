@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetOrderReserve set the hidden reserve of an iceberg order in the store
+func (k Keeper) SetOrderReserve(ctx sdk.Context, reserve types.OrderReserve) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderReserveKeyPrefix))
+	b := k.cdc.MustMarshal(&reserve)
+	store.Set(types.OrderIDIndexKey(
+		reserve.PairIndex,
+		types.OrderSide(reserve.Side),
+		reserve.Id,
+	), b)
+}
+
+// GetOrderReserve returns the hidden reserve of an iceberg order from its pair, side and ID
+func (k Keeper) GetOrderReserve(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	id int32,
+) (val types.OrderReserve, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderReserveKeyPrefix))
+
+	b := store.Get(types.OrderIDIndexKey(
+		pairIndex,
+		side,
+		id,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOrderReserve removes the hidden reserve of an iceberg order from the store
+func (k Keeper) RemoveOrderReserve(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	id int32,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderReserveKeyPrefix))
+	store.Delete(types.OrderIDIndexKey(
+		pairIndex,
+		side,
+		id,
+	))
+}
+
+// GetAllOrderReserve returns all the hidden reserves of iceberg orders
+func (k Keeper) GetAllOrderReserve(ctx sdk.Context) (list []types.OrderReserve) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrderReserveKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OrderReserve
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetIcebergOrder sets an order resting on the book and hides the part of its amount beyond its display
+// amount in reserve
+func (k Keeper) SetIcebergOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	order types.Order,
+	displayAmount sdk.Int,
+) {
+	visible, hidden := types.SplitIcebergAmount(order.Amount, displayAmount)
+	order.Amount = visible
+	k.SetOrder(ctx, pairIndex, side, order)
+
+	if hidden.IsPositive() {
+		k.SetOrderReserve(ctx, types.OrderReserve{
+			PairIndex:     pairIndex,
+			Side:          uint32(side),
+			Id:            order.Id,
+			DisplayAmount: displayAmount,
+			Reserve:       hidden,
+		})
+	}
+}
+
+// refillIcebergOrder refills the visible slice of an iceberg order entirely liquidated from its hidden
+// reserve. The refill keeps the ID of the order and gets a new sequence so that it goes to the back of
+// the queue at its price
+func (k Keeper) refillIcebergOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	order types.Order,
+) {
+	reserve, found := k.GetOrderReserve(ctx, pairIndex, side, order.Id)
+	if !found {
+		return
+	}
+	k.RemoveOrderReserve(ctx, pairIndex, side, order.Id)
+
+	order.Sequence = k.nextOrderID(ctx, pairIndex, side)
+	order.Amount = reserve.Reserve
+	k.SetIcebergOrder(ctx, pairIndex, side, order, reserve.DisplayAmount)
+}
+
+// takeOrderReserve removes the hidden reserve of an order and returns its amount, zero if none
+func (k Keeper) takeOrderReserve(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	id int32,
+) sdk.Int {
+	reserve, found := k.GetOrderReserve(ctx, pairIndex, side, id)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	k.RemoveOrderReserve(ctx, pairIndex, side, id)
+
+	return reserve.Reserve
+}
+
+// orderReserveAmount returns the amount hidden in reserve of an order, zero if none
+func (k Keeper) orderReserveAmount(
+	ctx sdk.Context,
+	pairIndex string,
+	side types.OrderSide,
+	id int32,
+) sdk.Int {
+	reserve, found := k.GetOrderReserve(ctx, pairIndex, side, id)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return reserve.Reserve
+}
+
+// nextOrderID returns the next order ID of the book of one side of a pair and saves the book
+func (k Keeper) nextOrderID(ctx sdk.Context, pairIndex string, side types.OrderSide) int32 {
+	if side == types.BuySide {
+		book, found := k.GetBuyOrderBook(ctx, pairIndex)
+		if !found {
+			panic("Buy order book must exist")
+		}
+		id := book.Book.GetNextOrderID()
+		book.Book.IncrementNextOrderID()
+		k.SetBuyOrderBook(ctx, book)
+
+		return id
+	}
+
+	book, found := k.GetSellOrderBook(ctx, pairIndex)
+	if !found {
+		panic("Sell order book must exist")
+	}
+	id := book.Book.GetNextOrderID()
+	book.Book.IncrementNextOrderID()
+	k.SetSellOrderBook(ctx, book)
+
+	return id
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func TestIcebergOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	book := types.NewSellOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	k.SetSellOrderBook(ctx, book)
+
+	// only the display amount of iceberg orders is visible
+	icebergID, err := k.AppendSellOrder(ctx, book, MockAccount("0"), sdk.NewInt(100), sdk.NewDec(10), types.OrderExpiry{}, sdk.NewInt(30))
+	require.NoError(t, err)
+	otherID, err := k.AppendSellOrder(ctx, book, MockAccount("1"), sdk.NewInt(20), sdk.NewDec(10), types.OrderExpiry{}, sdk.ZeroInt())
+	require.NoError(t, err)
+
+	orders := k.GetAllOrder(ctx, testPairIndex, types.SellSide)
+	require.Len(t, orders, 2)
+	require.Equal(t, icebergID, orders[0].Id)
+	require.True(t, sdk.NewInt(30).Equal(orders[0].Amount))
+
+	reserve, found := k.GetOrderReserve(ctx, testPairIndex, types.SellSide, icebergID)
+	require.True(t, found)
+	require.True(t, sdk.NewInt(70).Equal(reserve.Reserve))
+
+	// the queries do not expose the reserve
+	queried, found := k.GetSellOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	k.LoadOrders(ctx, testPairIndex, types.SellSide, queried.Book)
+	require.True(t, sdk.NewInt(30).Equal(queried.Book.Orders[0].Amount))
+
+	// the hidden reserve can be filled
+	require.True(t, k.CanFillBuyOrder(ctx, testPairIndex, types.Order{Amount: sdk.NewInt(120), Price: sdk.NewDec(10)}, types.CancelResting))
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, types.Order{Amount: sdk.NewInt(121), Price: sdk.NewDec(10)}, types.CancelResting))
+
+	// a liquidated slice is refilled from the reserve behind the orders at the same price
+	_, liquidated, _, _, filled := k.FillBuyOrder(ctx, testPairIndex, types.Order{
+		Creator: MockAccount("2"),
+		Amount:  sdk.NewInt(40),
+		Price:   sdk.NewDec(10),
+	}, types.CancelResting)
+	require.True(t, filled)
+	require.Len(t, liquidated, 2)
+	require.Equal(t, icebergID, liquidated[0].Id)
+	require.True(t, sdk.NewInt(30).Equal(liquidated[0].Amount))
+	require.Equal(t, otherID, liquidated[1].Id)
+	require.True(t, sdk.NewInt(10).Equal(liquidated[1].Amount))
+
+	// the refill keeps the ID of the order, only its sequence moves it behind
+	orders = k.GetAllOrder(ctx, testPairIndex, types.SellSide)
+	require.Len(t, orders, 2)
+	require.Equal(t, otherID, orders[0].Id)
+	require.Equal(t, icebergID, orders[1].Id)
+	require.Equal(t, int32(2), orders[1].Sequence)
+	require.Equal(t, MockAccount("0"), orders[1].Creator)
+	require.True(t, sdk.NewInt(30).Equal(orders[1].Amount))
+
+	reserve, found = k.GetOrderReserve(ctx, testPairIndex, types.SellSide, icebergID)
+	require.True(t, found)
+	require.True(t, sdk.NewInt(40).Equal(reserve.Reserve))
+
+	refilled, err := k.GetOrderFromID(ctx, testPairIndex, types.SellSide, icebergID)
+	require.NoError(t, err)
+	require.Equal(t, orders[1], refilled)
+	require.Len(t, k.GetAllOrderLocationByCreator(ctx, MockAccount("0")), 1)
+
+	// the whole iceberg order is filled through successive refills
+	_, liquidated, _, _, filled = k.FillBuyOrder(ctx, testPairIndex, types.Order{
+		Creator: MockAccount("2"),
+		Amount:  sdk.NewInt(80),
+		Price:   sdk.NewDec(10),
+	}, types.CancelResting)
+	require.True(t, filled)
+	require.Len(t, liquidated, 4)
+	require.Empty(t, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+	require.Empty(t, k.GetAllOrderReserve(ctx))
+}
+
+func TestAmendIcebergOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	order := types.Order{Id: 0, Creator: GenAddress(), Amount: sdk.NewInt(100), Price: sdk.NewDec(20)}
+	k.SetIcebergOrder(ctx, testPairIndex, types.SellSide, order, sdk.NewInt(30))
+
+	// the reserve follows the re-inserted order
+	visible, found := k.GetOrder(ctx, testPairIndex, types.SellSide, order.Price, order.Id)
+	require.True(t, found)
+	amended := visible
+	amended.Id = 1
+	amended.Price = sdk.NewDec(15)
+	require.NoError(t, k.AmendOrder(ctx, "dex", "channel-0", "foo", "bar", types.SellSide, visible, amended))
+
+	_, found = k.GetOrderReserve(ctx, testPairIndex, types.SellSide, order.Id)
+	require.False(t, found)
+	reserve, found := k.GetOrderReserve(ctx, testPairIndex, types.SellSide, amended.Id)
+	require.True(t, found)
+	require.True(t, sdk.NewInt(70).Equal(reserve.Reserve))
+}
+
+func TestCancelRefilledIcebergOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	book := types.NewSellOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	k.SetSellOrderBook(ctx, book)
+
+	icebergID, err := k.AppendSellOrder(ctx, book, MockAccount("0"), sdk.NewInt(100), sdk.NewDec(10), types.OrderExpiry{}, sdk.NewInt(30))
+	require.NoError(t, err)
+	_, _, _, _, filled := k.FillBuyOrder(ctx, testPairIndex, types.Order{
+		Creator: MockAccount("1"),
+		Amount:  sdk.NewInt(30),
+		Price:   sdk.NewDec(10),
+	}, types.CancelResting)
+	require.True(t, filled)
+
+	// the refilled order is still found from the ID returned when it was placed
+	_, err = srv.CancelSellOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelSellOrder{
+		Creator:     MockAccount("1"),
+		Port:        "dex",
+		Channel:     "channel-0",
+		AmountDenom: "foo",
+		PriceDenom:  "bar",
+		OrderID:     icebergID,
+	})
+	require.EqualError(t, err, "Canceller must be creator")
+
+	// and removed with its index entries as its creator cancels it
+	order, err := k.GetOrderFromID(ctx, testPairIndex, types.SellSide, icebergID)
+	require.NoError(t, err)
+	require.True(t, sdk.NewInt(30).Equal(order.Amount))
+	k.RemoveOrder(ctx, testPairIndex, types.SellSide, order.Price, order.TimePriority())
+
+	require.Empty(t, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+	require.Empty(t, k.GetAllOrderLocationByCreator(ctx, MockAccount("0")))
+	_, err = k.GetOrderFromID(ctx, testPairIndex, types.SellSide, icebergID)
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+}
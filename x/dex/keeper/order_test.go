@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+var testPairIndex = types.OrderBookIndex("dex", "channel-0", "foo", "bar")
+
+func GenAddress() string {
+	pbk := ed25519.GenPrivKey().PubKey()
+	addr := pbk.Address()
+
+	return sdk.AccAddress(addr).String()
+}
+
+func GenOrder() (string, sdk.Int, sdk.Dec) {
+	return GenAddress(),
+		sdk.NewInt(rand.Int63n(1_000_000_000_000) + 1),
+		sdk.NewDecWithPrec(rand.Int63n(1_000_000_000_000)+1, 6)
+}
+
+func MockAccount(str string) string {
+	return str
+}
+
+func setOrders(k *keeper.Keeper, ctx sdk.Context, side types.OrderSide, list []types.Order) {
+	for _, order := range list {
+		k.SetOrder(ctx, testPairIndex, side, order)
+	}
+}
+
+func TestOrderGet(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	order := types.Order{Id: 3, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)}
+
+	for _, side := range []types.OrderSide{types.BuySide, types.SellSide} {
+		k.SetOrder(ctx, testPairIndex, side, order)
+
+		rst, found := k.GetOrder(ctx, testPairIndex, side, order.Price, order.Id)
+		require.True(t, found)
+		require.Equal(t, order, rst)
+
+		_, found = k.GetOrder(ctx, testPairIndex, side, sdk.NewDec(26), order.Id)
+		require.False(t, found)
+	}
+
+	// the orders of another pair are not visible
+	_, found := k.GetOrder(ctx, types.OrderBookIndex("dex", "channel-0", "bar", "foo"), types.BuySide, order.Price, order.Id)
+	require.False(t, found)
+}
+
+func TestOrderRemove(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	order := types.Order{Id: 3, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)}
+	k.SetOrder(ctx, testPairIndex, types.BuySide, order)
+	k.SetOrder(ctx, testPairIndex, types.SellSide, order)
+
+	k.RemoveOrder(ctx, testPairIndex, types.BuySide, order.Price, order.Id)
+	_, found := k.GetOrder(ctx, testPairIndex, types.BuySide, order.Price, order.Id)
+	require.False(t, found)
+
+	// the other side is left untouched
+	_, found = k.GetOrder(ctx, testPairIndex, types.SellSide, order.Price, order.Id)
+	require.True(t, found)
+}
+
+func TestOrderGetAll(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	list := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		{Id: 3, Creator: MockAccount("3"), Amount: sdk.NewInt(10), Price: sdk.NewDec(20)},
+		{Id: 4, Creator: MockAccount("4"), Amount: sdk.NewInt(10), Price: sdk.NewDecWithPrec(1505, 2)},
+	}
+	setOrders(k, ctx, types.BuySide, list)
+	setOrders(k, ctx, types.SellSide, list)
+
+	// highest bid first, older orders first at the same price
+	require.Equal(t, []types.Order{list[0], list[1], list[3], list[4], list[2]},
+		k.GetAllOrder(ctx, testPairIndex, types.BuySide),
+	)
+
+	// lowest ask first, older orders first at the same price
+	require.Equal(t, []types.Order{list[2], list[4], list[1], list[3], list[0]},
+		k.GetAllOrder(ctx, testPairIndex, types.SellSide),
+	)
+
+	best, found := k.GetBestOrder(ctx, testPairIndex, types.BuySide)
+	require.True(t, found)
+	require.Equal(t, list[0], best)
+
+	_, found = k.GetBestOrder(ctx, types.OrderBookIndex("dex", "channel-0", "bar", "foo"), types.BuySide)
+	require.False(t, found)
+}
+
+func TestOrderGetFromID(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	list := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+	}
+	setOrders(k, ctx, types.SellSide, list)
+
+	for _, order := range list {
+		rst, err := k.GetOrderFromID(ctx, testPairIndex, types.SellSide, order.Id)
+		require.NoError(t, err)
+		require.Equal(t, order, rst)
+	}
+
+	_, err := k.GetOrderFromID(ctx, testPairIndex, types.SellSide, 3)
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+
+	_, err = k.GetOrderFromID(ctx, testPairIndex, types.BuySide, 0)
+	require.ErrorIs(t, err, types.ErrOrderNotFound)
+}
+
+func TestOrderIndexes(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	otherPairIndex := types.OrderBookIndex("dex", "channel-0", "bar", "foo")
+	list := []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 2, Creator: MockAccount("0"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+	}
+	setOrders(k, ctx, types.SellSide, list)
+	k.SetOrder(ctx, otherPairIndex, types.BuySide, list[0])
+
+	location, found := k.GetOrderLocation(ctx, testPairIndex, types.SellSide, 2)
+	require.True(t, found)
+	require.Equal(t, types.OrderLocation{
+		PairIndex: testPairIndex,
+		Side:      uint32(types.SellSide),
+		Price:     sdk.NewDec(15),
+		Id:        2,
+	}, location)
+
+	// orders of a creator across pairs
+	require.Equal(t, []types.OrderLocation{
+		{PairIndex: otherPairIndex, Side: uint32(types.BuySide), Price: sdk.NewDec(25), Id: 0},
+		{PairIndex: testPairIndex, Side: uint32(types.SellSide), Price: sdk.NewDec(25), Id: 0},
+		{PairIndex: testPairIndex, Side: uint32(types.SellSide), Price: sdk.NewDec(15), Id: 2},
+	}, k.GetAllOrderLocationByCreator(ctx, MockAccount("0")))
+	require.Empty(t, k.GetAllOrderLocationByCreator(ctx, MockAccount("2")))
+
+	// removed orders are removed from the indexes
+	k.RemoveOrder(ctx, testPairIndex, types.SellSide, sdk.NewDec(15), 2)
+	_, found = k.GetOrderLocation(ctx, testPairIndex, types.SellSide, 2)
+	require.False(t, found)
+	require.Len(t, k.GetAllOrderLocationByCreator(ctx, MockAccount("0")), 2)
+
+	// liquidated orders are removed from the indexes, partially filled ones are kept
+	_, _, _, _, filled := k.FillBuyOrder(ctx, testPairIndex, types.Order{
+		Id:      10,
+		Creator: MockAccount("2"),
+		Amount:  sdk.NewInt(220),
+		Price:   sdk.NewDec(30),
+	}, types.CancelResting)
+	require.True(t, filled)
+	_, found = k.GetOrderLocation(ctx, testPairIndex, types.SellSide, 1)
+	require.False(t, found)
+	order, err := k.GetOrderFromID(ctx, testPairIndex, types.SellSide, 0)
+	require.NoError(t, err)
+	require.True(t, sdk.NewInt(30).Equal(order.Amount))
+	require.Len(t, k.GetAllOrderLocationByCreator(ctx, MockAccount("0")), 2)
+	require.Empty(t, k.GetAllOrderLocationByCreator(ctx, MockAccount("1")))
+}
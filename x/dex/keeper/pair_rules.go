@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"errors"
+
+	"interchange-nel/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPairRules sets the trading rules of the order books of a pair on this chain. The orders already on
+// the books are left untouched
+func (k Keeper) SetPairRules(ctx sdk.Context, pairIndex string, rules types.PairRules) error {
+	sellOrderBook, sellFound := k.GetSellOrderBook(ctx, pairIndex)
+	if sellFound {
+		sellOrderBook.Rules = rules
+		k.SetSellOrderBook(ctx, sellOrderBook)
+	}
+
+	buyOrderBook, buyFound := k.GetBuyOrderBook(ctx, pairIndex)
+	if buyFound {
+		buyOrderBook.Rules = rules
+		k.SetBuyOrderBook(ctx, buyOrderBook)
+	}
+
+	if !sellFound && !buyFound {
+		return errors.New("The pair doesn't exist")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func TestSetPairRules(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	rules := types.NewPairRules(sdk.NewDecWithPrec(5, 1), sdk.NewInt(10), sdk.NewInt(20), sdk.NewInt(100), sdk.NewInt(30))
+
+	require.Error(t, k.SetPairRules(ctx, testPairIndex, rules))
+
+	// the books of the pair on this chain are updated
+	sellOrderBook := types.NewSellOrderBook("foo", "bar")
+	sellOrderBook.Index = testPairIndex
+	k.SetSellOrderBook(ctx, sellOrderBook)
+	require.NoError(t, k.SetPairRules(ctx, testPairIndex, rules))
+
+	sellOrderBook, found := k.GetSellOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.Equal(t, rules, sellOrderBook.Rules)
+
+	// orders breaking the rules are not appended
+	_, err := k.AppendSellOrder(ctx, sellOrderBook, MockAccount("0"), sdk.NewInt(15), sdk.NewDec(2), types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrLotSize)
+	_, err = k.AppendSellOrder(ctx, sellOrderBook, MockAccount("0"), sdk.NewInt(20), sdk.NewDecWithPrec(12, 1), types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrTickSize)
+	_, err = k.AppendSellOrder(ctx, sellOrderBook, MockAccount("0"), sdk.NewInt(20), sdk.NewDecWithPrec(15, 1), types.OrderExpiry{}, sdk.ZeroInt())
+	require.NoError(t, err)
+	require.Len(t, k.GetAllOrder(ctx, testPairIndex, types.SellSide), 1)
+}
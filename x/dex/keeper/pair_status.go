@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"errors"
+
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPairHalted halts or resumes the trading of a pair on this chain. The new orders of a halted pair are
+// rejected while its resting orders can still be cancelled
+func (k Keeper) SetPairHalted(ctx sdk.Context, pairIndex string, halted bool) error {
+	sellOrderBook, sellFound := k.GetSellOrderBook(ctx, pairIndex)
+	if sellFound {
+		sellOrderBook.Halted = halted
+		k.SetSellOrderBook(ctx, sellOrderBook)
+	}
+
+	buyOrderBook, buyFound := k.GetBuyOrderBook(ctx, pairIndex)
+	if buyFound {
+		buyOrderBook.Halted = halted
+		k.SetBuyOrderBook(ctx, buyOrderBook)
+	}
+
+	if !sellFound && !buyFound {
+		return errors.New("The pair doesn't exist")
+	}
+
+	return nil
+}
+
+// isPairTradable returns whether the book of one side of a pair exists and is not halted
+func (k Keeper) isPairTradable(ctx sdk.Context, pairIndex string, side types.OrderSide) bool {
+	if side == types.BuySide {
+		book, found := k.GetBuyOrderBook(ctx, pairIndex)
+		return found && !book.Halted
+	}
+
+	book, found := k.GetSellOrderBook(ctx, pairIndex)
+	return found && !book.Halted
+}
+
+// DelistPair removes the order books of a pair on this chain. Their resting orders, with the hidden reserve
+// of iceberg orders, and the stop orders of the pair are cancelled and refunded to their creators
+func (k Keeper) DelistPair(ctx sdk.Context, pairIndex string) error {
+	sellOrderBook, sellFound := k.GetSellOrderBook(ctx, pairIndex)
+	buyOrderBook, buyFound := k.GetBuyOrderBook(ctx, pairIndex)
+	if !sellFound && !buyFound {
+		return errors.New("The pair doesn't exist")
+	}
+
+	if sellFound {
+		if err := k.refundRestingOrders(
+			ctx,
+			sellOrderBook.Port,
+			sellOrderBook.Channel,
+			sellOrderBook.AmountDenom,
+			sellOrderBook.PriceDenom,
+			pairIndex,
+			types.SellSide,
+		); err != nil {
+			return err
+		}
+		k.RemoveSellOrderBook(ctx, pairIndex)
+	}
+
+	if buyFound {
+		if err := k.refundRestingOrders(
+			ctx,
+			buyOrderBook.Port,
+			buyOrderBook.Channel,
+			buyOrderBook.AmountDenom,
+			buyOrderBook.PriceDenom,
+			pairIndex,
+			types.BuySide,
+		); err != nil {
+			return err
+		}
+		k.RemoveBuyOrderBook(ctx, pairIndex)
+	}
+
+	// the stop orders would never be triggered without the books of the pair
+	for _, side := range []types.OrderSide{types.SellSide, types.BuySide} {
+		for _, stopOrder := range k.getPairStopOrders(ctx, pairIndex, side) {
+			k.RemoveStopOrder(ctx, stopOrder.Id)
+			if err := k.RefundStopOrder(ctx, stopOrder); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// refundRestingOrders removes all the orders on one side of a pair and refunds their creators like a
+// cancellation
+func (k Keeper) refundRestingOrders(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	amountDenom string,
+	priceDenom string,
+	pairIndex string,
+	side types.OrderSide,
+) error {
+	for _, order := range k.GetAllOrder(ctx, pairIndex, side) {
+		k.RemoveOrder(ctx, pairIndex, side, order.Price, order.TimePriority())
+		order.Amount = order.Amount.Add(k.takeOrderReserve(ctx, pairIndex, side, order.Id))
+		if err := k.RefundOrder(ctx, port, channel, amountDenom, priceDenom, side, order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getPairStopOrders returns the stop orders on one side of a pair
+func (k Keeper) getPairStopOrders(ctx sdk.Context, pairIndex string, side types.OrderSide) (list []types.StopOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderTriggerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.OrderBookSideKey(pairIndex, side))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		stopOrder, found := k.GetStopOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if found {
+			list = append(list, stopOrder)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func TestSetPairHalted(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	require.Error(t, k.SetPairHalted(ctx, testPairIndex, true))
+
+	sellOrderBook := types.NewSellOrderBook("foo", "bar")
+	sellOrderBook.Index = testPairIndex
+	k.SetSellOrderBook(ctx, sellOrderBook)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+	require.NoError(t, k.SetPairHalted(ctx, testPairIndex, true))
+
+	sellOrderBook, found := k.GetSellOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.True(t, sellOrderBook.Halted)
+
+	// the new orders of the pair are rejected, sent or received
+	_, err := srv.SendSellOrder(sdk.WrapSDKContext(ctx), &types.MsgSendSellOrder{
+		Creator:     MockAccount("0"),
+		Port:        "dex",
+		ChannelID:   "channel-0",
+		AmountDenom: "foo",
+		Amount:      sdk.NewInt(10),
+		PriceDenom:  "bar",
+		Price:       sdk.NewDec(20),
+	})
+	require.ErrorIs(t, err, types.ErrPairHalted)
+
+	_, _, err = k.OnRecvBuyOrderPacket(
+		ctx,
+		channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"},
+		types.BuyOrderPacketData{
+			AmountDenom: "foo",
+			Amount:      sdk.NewInt(10),
+			PriceDenom:  "bar",
+			Price:       sdk.NewDec(20),
+			Buyer:       MockAccount("3"),
+		},
+	)
+	require.ErrorIs(t, err, types.ErrPairHalted)
+	require.Equal(t, selfTradeSellBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+
+	// the resting orders cannot be amended
+	_, err = srv.AmendOrder(sdk.WrapSDKContext(ctx), types.NewMsgAmendOrder(
+		MockAccount("2"), "dex", "channel-0", "foo", "bar", types.SellSide, 2, sdk.NewInt(20), sdk.NewDec(15),
+	))
+	require.ErrorIs(t, err, types.ErrPairHalted)
+
+	// resuming the pair accepts its orders again
+	require.NoError(t, k.SetPairHalted(ctx, testPairIndex, false))
+	sellOrderBook, found = k.GetSellOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.False(t, sellOrderBook.Halted)
+}
+
+func TestDelistPair(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+
+	require.Error(t, k.DelistPair(ctx, testPairIndex))
+
+	sellOrderBook := types.NewSellOrderBook("foo", "bar")
+	sellOrderBook.Index = testPairIndex
+	k.SetSellOrderBook(ctx, sellOrderBook)
+	buyOrderBook := types.NewBuyOrderBook("foo", "bar")
+	buyOrderBook.Index = testPairIndex
+	k.SetBuyOrderBook(ctx, buyOrderBook)
+
+	// the books of the pair on this chain are removed
+	require.NoError(t, k.DelistPair(ctx, testPairIndex))
+	_, found := k.GetSellOrderBook(ctx, testPairIndex)
+	require.False(t, found)
+	_, found = k.GetBuyOrderBook(ctx, testPairIndex)
+	require.False(t, found)
+	require.Error(t, k.SetPairHalted(ctx, testPairIndex, true))
+}
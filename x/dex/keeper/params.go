@@ -1,16 +1,82 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"interchange-nel/x/dex/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.TradeRetention(ctx),
+		k.CandleHorizon(ctx),
+		k.MakerFeeRate(ctx),
+		k.TakerFeeRate(ctx),
+		k.FeeDistribution(ctx),
+		k.MaxAmount(ctx),
+		k.MaxPrice(ctx),
+		k.TriggeredStopOrdersLimit(ctx),
+		k.ExpiredOrdersPruneLimit(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// TradeRetention returns the TradeRetention param
+func (k Keeper) TradeRetention(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyTradeRetention, &res)
+	return
+}
+
+// CandleHorizon returns the CandleHorizon param
+func (k Keeper) CandleHorizon(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyCandleHorizon, &res)
+	return
+}
+
+// MakerFeeRate returns the MakerFeeRate param
+func (k Keeper) MakerFeeRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMakerFeeRate, &res)
+	return
+}
+
+// TakerFeeRate returns the TakerFeeRate param
+func (k Keeper) TakerFeeRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyTakerFeeRate, &res)
+	return
+}
+
+// FeeDistribution returns the FeeDistribution param
+func (k Keeper) FeeDistribution(ctx sdk.Context) (res types.FeeDistribution) {
+	k.paramstore.Get(ctx, types.KeyFeeDistribution, &res)
+	return
+}
+
+// MaxAmount returns the MaxAmount param
+func (k Keeper) MaxAmount(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMaxAmount, &res)
+	return
+}
+
+// MaxPrice returns the MaxPrice param
+func (k Keeper) MaxPrice(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxPrice, &res)
+	return
+}
+
+// TriggeredStopOrdersLimit returns the TriggeredStopOrdersLimit param
+func (k Keeper) TriggeredStopOrdersLimit(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyTriggeredStopOrdersLimit, &res)
+	return
+}
+
+// ExpiredOrdersPruneLimit returns the ExpiredOrdersPruneLimit param
+func (k Keeper) ExpiredOrdersPruneLimit(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyExpiredOrdersPruneLimit, &res)
+	return
+}
@@ -3,8 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 	testkeeper "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
 	"interchange-nel/x/dex/types"
 )
 
@@ -16,3 +19,68 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestOrderLimits(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	params := types.DefaultParams()
+	params.MaxAmount = sdk.NewInt(100)
+	params.MaxPrice = sdk.NewDec(50)
+	k.SetParams(ctx, params)
+
+	sellOrderBook := types.NewSellOrderBook("foo", "bar")
+	sellOrderBook.Index = testPairIndex
+	k.SetSellOrderBook(ctx, sellOrderBook)
+
+	// the orders above the limits are rejected when sent
+	_, err := srv.SendSellOrder(sdk.WrapSDKContext(ctx), &types.MsgSendSellOrder{
+		Creator:     MockAccount("0"),
+		Port:        "dex",
+		ChannelID:   "channel-0",
+		AmountDenom: "foo",
+		Amount:      sdk.NewInt(101),
+		PriceDenom:  "bar",
+		Price:       sdk.NewDec(20),
+	})
+	require.ErrorIs(t, err, types.ErrMaxAmount)
+
+	_, err = srv.SendSellOrder(sdk.WrapSDKContext(ctx), &types.MsgSendSellOrder{
+		Creator:     MockAccount("0"),
+		Port:        "dex",
+		ChannelID:   "channel-0",
+		AmountDenom: "foo",
+		Amount:      sdk.NewInt(10),
+		PriceDenom:  "bar",
+		Price:       sdk.NewDec(51),
+	})
+	require.ErrorIs(t, err, types.ErrMaxPrice)
+
+	// and when received, before matching
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+	_, _, err = k.OnRecvBuyOrderPacket(
+		ctx,
+		channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"},
+		types.BuyOrderPacketData{
+			AmountDenom: "foo",
+			Amount:      sdk.NewInt(101),
+			PriceDenom:  "bar",
+			Price:       sdk.NewDec(20),
+			Buyer:       MockAccount("3"),
+		},
+	)
+	require.ErrorIs(t, err, types.ErrMaxAmount)
+
+	_, _, err = k.OnRecvBuyOrderPacket(
+		ctx,
+		channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"},
+		types.BuyOrderPacketData{
+			AmountDenom: "foo",
+			Amount:      sdk.NewInt(10),
+			PriceDenom:  "bar",
+			Price:       sdk.NewDec(51),
+			Buyer:       MockAccount("3"),
+		},
+	)
+	require.ErrorIs(t, err, types.ErrMaxPrice)
+	require.Equal(t, selfTradeSellBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"interchange-nel/x/dex/types"
+)
+
+// SetPendingOrder set a specific pendingOrder in the store and indexes it by creator
+func (k Keeper) SetPendingOrder(ctx sdk.Context, pendingOrder types.PendingOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+	key := types.PendingOrderKey(pendingOrder.Port, pendingOrder.Channel, pendingOrder.Sequence)
+	b := k.cdc.MustMarshal(&pendingOrder)
+	store.Set(key, b)
+
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderAccountKeyPrefix))
+	accountStore.Set(append(types.PendingOrderAccountKey(pendingOrder.Creator), key...), []byte{})
+}
+
+// GetPendingOrder returns a pendingOrder from the port, channel and sequence of its packet
+func (k Keeper) GetPendingOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	sequence uint64,
+) (val types.PendingOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+
+	b := store.Get(types.PendingOrderKey(port, channel, sequence))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingOrder removes a pendingOrder and its index entry from the store
+func (k Keeper) RemovePendingOrder(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	sequence uint64,
+) {
+	pendingOrder, found := k.GetPendingOrder(ctx, port, channel, sequence)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+	key := types.PendingOrderKey(port, channel, sequence)
+	store.Delete(key)
+
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderAccountKeyPrefix))
+	accountStore.Delete(append(types.PendingOrderAccountKey(pendingOrder.Creator), key...))
+}
+
+// GetAllPendingOrder returns all pendingOrder
+func (k Keeper) GetAllPendingOrder(ctx sdk.Context) (list []types.PendingOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOrderKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordPendingOrder records an order whose packet has been sent, its funds being escrowed until the
+// packet is acknowledged or times out
+func (k Keeper) recordPendingOrder(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	pendingOrder types.PendingOrder,
+) {
+	pendingOrder.Port = packet.SourcePort
+	pendingOrder.Channel = packet.SourceChannel
+	pendingOrder.Sequence = packet.Sequence
+	pendingOrder.TimeoutTimestamp = packet.TimeoutTimestamp
+	pendingOrder.Status = types.PendingInFlight
+
+	k.SetPendingOrder(ctx, pendingOrder)
+}
+
+// withPendingStatus returns a pending order with its status at the current block, timed out once the
+// block time reaches its timeout
+func withPendingStatus(ctx sdk.Context, pendingOrder types.PendingOrder) types.PendingOrder {
+	pendingOrder.Status = types.PendingInFlight
+	if pendingOrder.TimeoutTimestamp != 0 && uint64(ctx.BlockTime().UnixNano()) >= pendingOrder.TimeoutTimestamp {
+		pendingOrder.Status = types.PendingTimedOut
+	}
+
+	return pendingOrder
+}
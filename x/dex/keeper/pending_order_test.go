@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/testutil/nullify"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func pendingOrder(creator string, channel string, sequence uint64, timeout uint64) types.PendingOrder {
+	return types.PendingOrder{
+		Port:             "dex",
+		Channel:          channel,
+		Sequence:         sequence,
+		Creator:          creator,
+		Side:             uint32(types.BuySide),
+		AmountDenom:      "foo",
+		Amount:           sdk.NewInt(10),
+		PriceDenom:       "bar",
+		Price:            sdk.NewDec(2),
+		EscrowDenom:      "bar",
+		EscrowAmount:     sdk.NewInt(20),
+		TimeoutTimestamp: timeout,
+	}
+}
+
+func setPendingOrders(k *keeper.Keeper, ctx sdk.Context, list []types.PendingOrder) []types.PendingOrder {
+	for _, elem := range list {
+		k.SetPendingOrder(ctx, elem)
+	}
+	return list
+}
+
+func TestPendingOrderGet(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	list := setPendingOrders(k, ctx, []types.PendingOrder{
+		pendingOrder(MockAccount("0"), "channel-0", 1, 0),
+		pendingOrder(MockAccount("1"), "channel-1", 1, 0),
+	})
+
+	for _, elem := range list {
+		rst, found := k.GetPendingOrder(ctx, elem.Port, elem.Channel, elem.Sequence)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(elem), nullify.Fill(rst))
+	}
+	require.Len(t, k.GetAllPendingOrder(ctx), 2)
+
+	k.RemovePendingOrder(ctx, "dex", "channel-0", 1)
+	_, found := k.GetPendingOrder(ctx, "dex", "channel-0", 1)
+	require.False(t, found)
+	require.Equal(t, nullify.Fill([]types.PendingOrder{list[1]}), nullify.Fill(k.GetAllPendingOrder(ctx)))
+
+	// removing an unknown pending order is a no-op
+	k.RemovePendingOrder(ctx, "dex", "channel-0", 1)
+	require.Len(t, k.GetAllPendingOrder(ctx), 1)
+}
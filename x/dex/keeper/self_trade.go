@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"interchange-nel/x/dex/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// preventSelfTrade applies the self-trade prevention mode of an incoming order to the best order of the
+// resting side when both have the same creator and would match. It returns the incoming order left to
+// match and whether matching must stop
+func (k Keeper) preventSelfTrade(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	incoming types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+	selfTrade *types.SelfTrade,
+) (types.Order, bool) {
+	resting, found := k.GetBestOrder(ctx, pairIndex, restingSide)
+	if !found || !types.IsSelfTrade(incoming, resting) || !crosses(restingSide, incoming, resting) {
+		return incoming, false
+	}
+
+	return k.applySelfTradePrevention(ctx, pairIndex, restingSide, incoming, resting, selfTradePrevention, selfTrade)
+}
+
+// preventLevelSelfTrades applies the self-trade prevention mode of an incoming order to the resting
+// orders of its creator at the best price level it would match, all the orders of a level sharing the
+// fills of the matchers that allocate whole levels. It returns the incoming order left to match and
+// whether matching must stop
+func (k Keeper) preventLevelSelfTrades(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	incoming types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+	selfTrade *types.SelfTrade,
+) (types.Order, bool) {
+	if incoming.Creator == "" {
+		return incoming, false
+	}
+
+	// a level emptied by self-trade prevention exposes the next one, until the best level has no order of
+	// the creator
+	for {
+		var ownOrders []types.Order
+		for _, resting := range k.bestLevel(ctx, pairIndex, restingSide, incoming, true) {
+			if types.IsSelfTrade(incoming, resting) {
+				ownOrders = append(ownOrders, resting)
+			}
+		}
+		if len(ownOrders) == 0 {
+			return incoming, false
+		}
+
+		for _, resting := range ownOrders {
+			var stop bool
+			incoming, stop = k.applySelfTradePrevention(
+				ctx,
+				pairIndex,
+				restingSide,
+				incoming,
+				resting,
+				selfTradePrevention,
+				selfTrade,
+			)
+			if stop {
+				return incoming, true
+			}
+		}
+	}
+}
+
+// preventSelfTrades applies the self-trade prevention mode of an incoming order to all the resting orders
+// of its creator it would match, regardless of their priority. It returns the incoming order left to match
+func (k Keeper) preventSelfTrades(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	incoming types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+	selfTrade *types.SelfTrade,
+) types.Order {
+	if incoming.Creator == "" {
+		return incoming
+	}
+
+	// the orders are collected first as preventing a self-trade modifies the book, until the refilled
+	// slices of iceberg orders are exhausted
+	for {
+		ownOrders := k.crossingOrdersOf(ctx, pairIndex, restingSide, incoming)
+		if len(ownOrders) == 0 {
+			return incoming
+		}
+
+		for _, resting := range ownOrders {
+			var stop bool
+			incoming, stop = k.applySelfTradePrevention(
+				ctx,
+				pairIndex,
+				restingSide,
+				incoming,
+				resting,
+				selfTradePrevention,
+				selfTrade,
+			)
+			if stop {
+				return incoming
+			}
+		}
+	}
+}
+
+// crossingOrdersOf returns the resting orders of the creator of an incoming order it would match
+func (k Keeper) crossingOrdersOf(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	incoming types.Order,
+) (list []types.Order) {
+	iterator := k.OrderIterator(ctx, pairIndex, restingSide)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var resting types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &resting)
+		if !crosses(restingSide, incoming, resting) {
+			break
+		}
+		if types.IsSelfTrade(incoming, resting) && !isExpired(ctx, resting) {
+			list = append(list, resting)
+		}
+	}
+
+	return list
+}
+
+// applySelfTradePrevention applies a self-trade prevention mode to an incoming order and a resting order
+// of its creator. It returns the incoming order left to match and whether matching must stop
+func (k Keeper) applySelfTradePrevention(
+	ctx sdk.Context,
+	pairIndex string,
+	restingSide types.OrderSide,
+	incoming types.Order,
+	resting types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+	selfTrade *types.SelfTrade,
+) (types.Order, bool) {
+	switch selfTradePrevention {
+	case types.CancelIncoming:
+		selfTrade.Incoming = selfTrade.Incoming.Add(incoming.Amount)
+		incoming.Amount = sdk.ZeroInt()
+
+		return incoming, true
+	case types.DecrementBoth:
+		decrement := sdk.MinInt(incoming.Amount, resting.Amount)
+		selfTrade.Incoming = selfTrade.Incoming.Add(decrement)
+		incoming.Amount = incoming.Amount.Sub(decrement)
+
+		refunded := resting
+		refunded.Amount = decrement
+		selfTrade.Resting = append(selfTrade.Resting, refunded)
+
+		resting.Amount = resting.Amount.Sub(decrement)
+		if resting.Amount.IsZero() {
+			k.RemoveOrder(ctx, pairIndex, restingSide, resting.Price, resting.TimePriority())
+			k.refillIcebergOrder(ctx, pairIndex, restingSide, resting)
+		} else {
+			k.SetOrder(ctx, pairIndex, restingSide, resting)
+		}
+
+		return incoming, incoming.Amount.IsZero()
+	default:
+		// the resting order is cancelled with the hidden reserve of iceberg orders
+		k.RemoveOrder(ctx, pairIndex, restingSide, resting.Price, resting.TimePriority())
+		resting.Amount = resting.Amount.Add(k.takeOrderReserve(ctx, pairIndex, restingSide, resting.Id))
+		selfTrade.Resting = append(selfTrade.Resting, resting)
+
+		return incoming, false
+	}
+}
+
+// crosses returns whether an incoming order matches a resting order on the given side
+func crosses(restingSide types.OrderSide, incoming types.Order, resting types.Order) bool {
+	if restingSide == types.SellSide {
+		return incoming.Price.GTE(resting.Price)
+	}
+
+	return incoming.Price.LTE(resting.Price)
+}
+
+// refundSelfTrade refunds the creators of the resting orders cancelled or decremented by self-trade
+// prevention with their escrowed tokens
+func (k Keeper) refundSelfTrade(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	amountDenom string,
+	priceDenom string,
+	restingSide types.OrderSide,
+	selfTrade types.SelfTrade,
+) error {
+	for _, order := range selfTrade.Resting {
+		if err := k.RefundOrder(ctx, port, channel, amountDenom, priceDenom, restingSide, order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
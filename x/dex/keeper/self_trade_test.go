@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+var selfTradeSellBook = []types.Order{
+	{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+	{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+	{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+}
+
+func TestSelfTradeCancelResting(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// the ask of the buyer is cancelled and the buy order fills against the next one
+	inputOrder := types.Order{Creator: MockAccount("2"), Amount: sdk.NewInt(60), Price: sdk.NewDec(22)}
+	remaining, liquidated, purchase, selfTrade, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+	require.True(t, filled)
+	require.True(t, remaining.Amount.IsZero())
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(60), Price: sdk.NewDec(20)},
+	}, liquidated)
+	require.True(t, sdk.NewInt(60).Equal(purchase))
+	require.Equal(t, []types.Order{selfTradeSellBook[0]}, selfTrade.Resting)
+	require.True(t, selfTrade.Incoming.IsZero())
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(140), Price: sdk.NewDec(20)},
+		selfTradeSellBook[2],
+	}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestSelfTradeCancelIncoming(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// the buy order is cancelled before reaching the ask of the buyer
+	inputOrder := types.Order{Creator: MockAccount("1"), Amount: sdk.NewInt(60), Price: sdk.NewDec(22)}
+	remaining, liquidated, purchase, selfTrade, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelIncoming)
+	require.False(t, filled)
+	require.True(t, remaining.Amount.IsZero())
+	require.Equal(t, []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+	}, liquidated)
+	require.True(t, sdk.NewInt(30).Equal(purchase))
+	require.Empty(t, selfTrade.Resting)
+	require.True(t, sdk.NewInt(30).Equal(selfTrade.Incoming))
+	require.Equal(t, selfTradeSellBook[1:], k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestSelfTradeDecrementBoth(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// the depleted ask of the buyer is removed and the rest of the buy order fills against the next one
+	inputOrder := types.Order{Creator: MockAccount("2"), Amount: sdk.NewInt(60), Price: sdk.NewDec(22)}
+	remaining, liquidated, purchase, selfTrade, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.DecrementBoth)
+	require.True(t, filled)
+	require.True(t, remaining.Amount.IsZero())
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(30), Price: sdk.NewDec(20)},
+	}, liquidated)
+	require.True(t, sdk.NewInt(30).Equal(purchase))
+	require.Equal(t, []types.Order{selfTradeSellBook[0]}, selfTrade.Resting)
+	require.True(t, sdk.NewInt(30).Equal(selfTrade.Incoming))
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(170), Price: sdk.NewDec(20)},
+		selfTradeSellBook[2],
+	}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+
+	// a larger ask of the buyer is decremented and stays on the book
+	inputOrder = types.Order{Creator: MockAccount("1"), Amount: sdk.NewInt(50), Price: sdk.NewDec(22)}
+	remaining, liquidated, _, selfTrade, _ = k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.DecrementBoth)
+	require.True(t, remaining.Amount.IsZero())
+	require.Empty(t, liquidated)
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(50), Price: sdk.NewDec(20)},
+	}, selfTrade.Resting)
+	require.True(t, sdk.NewInt(50).Equal(selfTrade.Incoming))
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(120), Price: sdk.NewDec(20)},
+		selfTradeSellBook[2],
+	}, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestSelfTradeSellOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	buyBook := []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(15)},
+	}
+	setOrders(k, ctx, types.BuySide, buyBook)
+
+	// the bid of the seller is cancelled and the sell order fills against the next one
+	inputOrder := types.Order{Creator: MockAccount("2"), Amount: sdk.NewInt(60), Price: sdk.NewDec(18)}
+	remaining, liquidated, gain, selfTrade, filled := k.FillSellOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+	require.True(t, filled)
+	require.True(t, remaining.Amount.IsZero())
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(60), Price: sdk.NewDec(20)},
+	}, liquidated)
+	require.True(t, sdk.NewInt(60*20).Equal(gain))
+	require.Equal(t, []types.Order{buyBook[0]}, selfTrade.Resting)
+	require.Equal(t, []types.Order{
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(140), Price: sdk.NewDec(20)},
+		buyBook[2],
+	}, k.GetAllOrder(ctx, testPairIndex, types.BuySide))
+}
+
+func TestSelfTradeNoCross(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// an ask of the buyer above the buy price is left untouched
+	inputOrder := types.Order{Creator: MockAccount("1"), Amount: sdk.NewInt(60), Price: sdk.NewDec(12)}
+	remaining, liquidated, _, selfTrade, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelIncoming)
+	require.False(t, filled)
+	require.Equal(t, inputOrder, remaining)
+	require.Empty(t, liquidated)
+	require.Empty(t, selfTrade.Resting)
+	require.True(t, selfTrade.Incoming.IsZero())
+	require.Equal(t, selfTradeSellBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestSelfTradeIcebergReserve(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	book := types.NewSellOrderBook("foo", "bar")
+	book.Index = testPairIndex
+	k.SetSellOrderBook(ctx, book)
+
+	icebergID, err := k.AppendSellOrder(ctx, book, MockAccount("0"), sdk.NewInt(100), sdk.NewDec(10), types.OrderExpiry{}, sdk.NewInt(30))
+	require.NoError(t, err)
+
+	// the cancelled iceberg order is refunded with its hidden reserve
+	inputOrder := types.Order{Creator: MockAccount("0"), Amount: sdk.NewInt(10), Price: sdk.NewDec(10)}
+	_, liquidated, _, selfTrade, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+	require.False(t, filled)
+	require.Empty(t, liquidated)
+	require.Len(t, selfTrade.Resting, 1)
+	require.Equal(t, icebergID, selfTrade.Resting[0].Id)
+	require.True(t, sdk.NewInt(100).Equal(selfTrade.Resting[0].Amount))
+	require.Empty(t, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+
+	_, found := k.GetOrderReserve(ctx, testPairIndex, types.SellSide, icebergID)
+	require.False(t, found)
+}
+
+func TestSelfTradeCanFill(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	// the cancelled asks of the buyer do not count toward the fill
+	order := types.Order{Creator: MockAccount("2"), Amount: sdk.NewInt(200), Price: sdk.NewDec(22)}
+	require.True(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelResting))
+	order.Amount = sdk.NewInt(201)
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	// the other modes never entirely fill an order crossing an ask of the buyer
+	order.Amount = sdk.NewInt(10)
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelIncoming))
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.DecrementBoth))
+
+	// the asks of the buyer beyond the amount to fill are not reached
+	order = types.Order{Creator: MockAccount("1"), Amount: sdk.NewInt(30), Price: sdk.NewDec(22)}
+	require.True(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelIncoming))
+}
@@ -76,6 +76,18 @@ func (k Keeper) TransmitSellOrderPacket(
 		return err
 	}
 
+	// the escrowed funds are tracked until the packet is acknowledged or times out
+	k.recordPendingOrder(ctx, packet, types.PendingOrder{
+		Creator:      packetData.Seller,
+		Side:         uint32(types.SellSide),
+		AmountDenom:  packetData.AmountDenom,
+		Amount:       packetData.Amount,
+		PriceDenom:   packetData.PriceDenom,
+		Price:        packetData.Price,
+		EscrowDenom:  packetData.AmountDenom,
+		EscrowAmount: packetData.Amount,
+	})
+
 	return nil
 }
 
@@ -84,10 +96,10 @@ func (k Keeper) OnRecvSellOrderPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data types.SellOrderPacketData,
-) (packetAck types.SellOrderPacketAck, err error) {
+) (packetAck types.SellOrderPacketAck, queued bool, err error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
-		return packetAck, err
+		return packetAck, false, err
 	}
 
 	pairIndex := types.OrderBookIndex(
@@ -98,18 +110,76 @@ func (k Keeper) OnRecvSellOrderPacket(
 	)
 	book, found := k.GetBuyOrderBook(ctx, pairIndex)
 	if !found {
-		return packetAck, errors.New("The pair does not exist")
+		return packetAck, false, errors.New("The pair does not exist")
+	}
+	if book.Halted {
+		return packetAck, false, types.ErrPairHalted
+	}
+
+	// check the order against the trading rules of the pair on this chain
+	if err := data.ValidateRules(book.Rules); err != nil {
+		return packetAck, false, err
+	}
+
+	// check the order against the maximum amount and price of the module
+	if err := data.ValidateLimits(k.GetParams(ctx)); err != nil {
+		return packetAck, false, err
+	}
+
+	// the creator identifies the orders of the same trader for self-trade prevention
+	order := types.Order{
+		Creator: data.Seller,
+		Amount:  data.Amount,
+		Price:   data.Price,
+	}
+
+	// post-only orders are rejected if they would take liquidity
+	if data.PostOnly && k.MatchesSellOrder(ctx, pairIndex, order) {
+		return packetAck, false, types.ErrPostOnlyWouldMatch
+	}
+
+	// the orders of batch auction pairs are queued until the auction clears at the end of the block and
+	// acknowledged then, post-only orders not crossing the book are acknowledged as they rest
+	if book.Rules.MatchingMode == types.BatchAuction && !data.PostOnly {
+		if data.TimeInForce == types.FillOrKill {
+			return packetAck, false, types.ErrBatchAuctionFillOrKill
+		}
+		k.AppendAuctionOrder(ctx, pairIndex, packet)
+
+		return packetAck, true, nil
+	}
+
+	// fill-or-kill orders are rejected unless they can be entirely filled
+	if data.TimeInForce == types.FillOrKill && !k.CanFillSellOrder(ctx, pairIndex, order, data.SelfTradePrevention) {
+		return packetAck, false, types.ErrFillOrKill
 	}
 
 	// fill the sell order
-	remaining, liquidated, gain, _ := book.FillSellOrder(types.Order{
-		Amount: data.Amount,
-		Price:  data.Price,
-	})
+	remaining, liquidated, gain, selfTrade, _ := k.FillSellOrder(ctx, pairIndex, order, data.SelfTradePrevention)
 
-	// return the remaining amount and gains
+	// return the remaining amount, gains and the amount cancelled by self-trade prevention
 	packetAck.RemainingAmount = remaining.Amount
 	packetAck.Gain = gain
+	packetAck.CancelledAmount = selfTrade.Incoming
+
+	// record the fills as trades, the source chain records them from the acknowledgement
+	packetAck.Fills = liquidated
+	packetAck.Height = ctx.BlockHeight()
+	packetAck.Timestamp = uint64(ctx.BlockTime().UnixNano())
+	k.RecordTrades(ctx, pairIndex, data.Seller, packet.Sequence, packetAck.Height, packetAck.Timestamp, liquidated)
+
+	// refund the bids cancelled by self-trade prevention
+	if err := k.refundSelfTrade(
+		ctx,
+		packet.DestinationPort,
+		packet.DestinationChannel,
+		data.AmountDenom,
+		data.PriceDenom,
+		types.BuySide,
+		selfTrade,
+	); err != nil {
+		return packetAck, false, err
+	}
 
 	// before distributing sales, we resolve the denom
 	// first check if the denom received comes from this chain originally
@@ -124,31 +194,29 @@ func (k Keeper) OnRecvSellOrderPacket(
 		finalAmountDenom = VoucherDenom(packet.SourcePort, packet.SourceChannel, data.AmountDenom)
 	}
 
-	// dispatch liquidated buy orders
+	// dispatch liquidated buy orders minus the maker fee, the taker fee is deducted from the gain on the
+	// source chain
+	makerFeeRate, takerFeeRate := k.FeeRates(ctx, book.Rules)
+	packetAck.MakerFee = sdk.ZeroInt()
+	packetAck.TakerFee = types.FeeAmount(gain, takerFeeRate)
 	for _, liquidation := range liquidated {
-		liquidation := liquidation
-		addr, err := sdk.AccAddressFromBech32(liquidation.Creator)
-		if err != nil {
-			return packetAck, err
-		}
-
-		err = k.SafeMint(
+		fee, err := k.payMaker(
 			ctx,
 			packet.DestinationPort,
 			packet.DestinationChannel,
-			addr,
+			pairIndex,
+			makerFeeRate,
+			liquidation.Creator,
 			finalAmountDenom,
 			liquidation.Amount,
 		)
 		if err != nil {
-			return packetAck, err
+			return packetAck, false, err
 		}
+		packetAck.MakerFee = packetAck.MakerFee.Add(fee)
 	}
 
-	// save the new order book
-	k.SetBuyOrderBook(ctx, book)
-
-	return packetAck, nil
+	return packetAck, false, nil
 }
 
 // OnAcknowledgementSellOrderPacket responds to the the success or failure of a packet
@@ -159,6 +227,9 @@ func (k Keeper) OnAcknowledgementSellOrderPacket(
 	data types.SellOrderPacketData,
 	ack channeltypes.Acknowledgement,
 ) error {
+	// the order is no longer in flight
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		// in case of error, we mint back the native token
@@ -196,24 +267,72 @@ func (k Keeper) OnAcknowledgementSellOrderPacket(
 			data.AmountDenom,
 			data.PriceDenom,
 		)
+		// the pair may have been halted or delisted while the order was in flight
 		book, found := k.GetSellOrderBook(ctx, pairIndex)
-		if !found {
-			panic("Sell order book must exist")
+
+		// record the fills executed on the counterparty chain as trades
+		k.RecordTrades(
+			ctx,
+			pairIndex,
+			data.Seller,
+			packet.Sequence,
+			packetAck.Height,
+			packetAck.Timestamp,
+			packetAck.Fills,
+		)
+
+		// refund the amount cancelled by self-trade prevention
+		refund := packetAck.CancelledAmount
+		if refund.IsNil() {
+			refund = sdk.ZeroInt()
 		}
 
-		// append the remaining amount of the order
-		if packetAck.RemainingAmount > 0 {
-			_, err := book.AppendOrder(data.Seller, packetAck.RemainingAmount, data.Price)
+		// append the remaining amount of orders resting on the book and refund the one of the others, the
+		// one left outside the limits of the pair or of the params or without quote amount and the one of
+		// halted and delisted pairs
+		if packetAck.RemainingAmount.IsPositive() {
+			if !found || book.Halted || !data.RestsOnBook() ||
+				book.Rules.ValidateOrder(packetAck.RemainingAmount, data.Price) != nil ||
+				k.GetParams(ctx).ValidateOrder(packetAck.RemainingAmount, data.Price) != nil ||
+				types.ValidateQuote(packetAck.RemainingAmount, data.Price) != nil {
+				refund = refund.Add(packetAck.RemainingAmount)
+			} else {
+				_, err := k.AppendSellOrder(
+					ctx,
+					book,
+					data.Seller,
+					packetAck.RemainingAmount,
+					data.Price,
+					data.Expiry,
+					data.DisplayAmount,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if refund.IsPositive() {
+			receiver, err := sdk.AccAddressFromBech32(data.Seller)
 			if err != nil {
 				return err
 			}
 
-			// save the new order book
-			k.SetSellOrderBook(ctx, book)
+			if err := k.SafeMint(
+				ctx,
+				packet.SourcePort,
+				packet.SourceChannel,
+				receiver,
+				data.AmountDenom,
+				refund,
+			); err != nil {
+				return err
+			}
 		}
 
-		// mint the gains
-		if packetAck.Gain > 0 {
+		// mint the gains minus the taker fee
+		gain, takerFee := packetAck.NetProceeds()
+		if packetAck.Gain.IsPositive() {
 			receiver, err := sdk.AccAddressFromBech32(data.Seller)
 			if err != nil {
 				return err
@@ -240,11 +359,24 @@ func (k Keeper) OnAcknowledgementSellOrderPacket(
 				packet.SourceChannel,
 				receiver,
 				finalPriceDenom,
-				packetAck.Gain,
+				gain,
 			)
 			if err != nil {
 				return err
 			}
+
+			if err := k.CollectFee(
+				ctx,
+				packet.SourcePort,
+				packet.SourceChannel,
+				pairIndex,
+				data.Seller,
+				types.AttributeValueTaker,
+				finalPriceDenom,
+				takerFee,
+			); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -261,6 +393,9 @@ func (k Keeper) OnTimeoutSellOrderPacket(
 	packet channeltypes.Packet,
 	data types.SellOrderPacketData,
 ) error {
+	// the order is no longer in flight
+	k.RemovePendingOrder(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 	// in case of error we mint back the native token
 	receiver, err := sdk.AccAddressFromBech32(data.Seller)
 	if err != nil {
@@ -280,6 +415,4 @@ func (k Keeper) OnTimeoutSellOrderPacket(
 	}
 
 	return nil
-
-	return nil
 }
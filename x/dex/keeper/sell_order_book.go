@@ -9,6 +9,13 @@ import (
 
 // SetSellOrderBook set a specific sellOrderBook in the store from its index
 func (k Keeper) SetSellOrderBook(ctx sdk.Context, sellOrderBook types.SellOrderBook) {
+	// orders are stored individually, only the book metadata is kept here
+	if sellOrderBook.Book != nil {
+		book := *sellOrderBook.Book
+		book.Orders = nil
+		sellOrderBook.Book = &book
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SellOrderBookKeyPrefix))
 	b := k.cdc.MustMarshal(&sellOrderBook)
 	store.Set(types.SellOrderBookKey(
@@ -62,3 +69,203 @@ func (k Keeper) GetAllSellOrderBook(ctx sdk.Context) (list []types.SellOrderBook
 
 	return
 }
+
+// AppendSellOrder appends a new order to the sell order book and saves the book after checking it against
+// the trading rules of the pair. The amount of iceberg orders beyond their display amount is hidden in
+// reserve
+func (k Keeper) AppendSellOrder(
+	ctx sdk.Context,
+	book types.SellOrderBook,
+	creator string,
+	amount sdk.Int,
+	price sdk.Dec,
+	expiry types.OrderExpiry,
+	displayAmount sdk.Int,
+) (int32, error) {
+	if err := book.Rules.ValidateOrder(amount, price); err != nil {
+		return 0, err
+	}
+
+	order, err := book.Book.NewOrder(k.GetParams(ctx), creator, amount, price)
+	if err != nil {
+		return 0, err
+	}
+	order.Expiry = expiry
+
+	k.SetIcebergOrder(ctx, book.Index, types.SellSide, order, displayAmount)
+	k.SetSellOrderBook(ctx, book)
+
+	return order.Id, nil
+}
+
+// MatchesBuyOrder returns whether a buy order would match the lowest ask of the pair
+func (k Keeper) MatchesBuyOrder(ctx sdk.Context, pairIndex string, order types.Order) bool {
+	lowestAsk, found := k.GetBestOrder(ctx, pairIndex, types.SellSide)
+
+	return found && order.Price.GTE(lowestAsk.Price)
+}
+
+// CanFillBuyOrder returns whether a buy order can be entirely filled against the sell order book of the
+// pair, without modifying the book
+func (k Keeper) CanFillBuyOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	order types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+) bool {
+	iterator := k.OrderIterator(ctx, pairIndex, types.SellSide)
+	defer iterator.Close()
+
+	// sum the lowest asks as long as there is match
+	matchable := sdk.ZeroInt()
+	sharesLevel := k.pairMatcher(ctx, pairIndex, types.SellSide).SharesLevel()
+	var levelPrice sdk.Dec
+	for ; iterator.Valid(); iterator.Next() {
+		var ask types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &ask)
+		if order.Price.LT(ask.Price) {
+			break
+		}
+		if isExpired(ctx, ask) {
+			continue
+		}
+
+		// the orders of a level sharing the fills, the order must be matchable once a level is complete
+		if sharesLevel && !levelPrice.IsNil() && !ask.Price.Equal(levelPrice) && matchable.GTE(order.Amount) {
+			return true
+		}
+		levelPrice = ask.Price
+
+		// the resting orders of the creator are skipped if cancelled, otherwise they prevent the fill
+		if types.IsSelfTrade(order, ask) {
+			if selfTradePrevention != types.CancelResting {
+				return false
+			}
+			continue
+		}
+
+		// the hidden reserve of iceberg orders refills the book while filling
+		matchable = matchable.Add(ask.Amount).Add(k.orderReserveAmount(ctx, pairIndex, types.SellSide, ask.Id))
+		if !sharesLevel && matchable.GTE(order.Amount) {
+			return true
+		}
+	}
+
+	return matchable.GTE(order.Amount)
+}
+
+// AffordableBuyOrder returns the part of a market buy order without protection price the maximum quote
+// affords against the lowest asks of the pair, priced at the highest ask reached. The quote of each ask
+// is rounded up, so that the quote spent by the fill never exceeds the maximum quote
+func (k Keeper) AffordableBuyOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	order types.Order,
+	maxQuote sdk.Int,
+) types.Order {
+	iterator := k.OrderIterator(ctx, pairIndex, types.SellSide)
+	defer iterator.Close()
+
+	affordable := order
+	affordable.Amount = sdk.ZeroInt()
+	affordable.Price = sdk.ZeroDec()
+	budget := maxQuote
+	for ; iterator.Valid() && affordable.Amount.LT(order.Amount); iterator.Next() {
+		var ask types.Order
+		k.cdc.MustUnmarshal(iterator.Value(), &ask)
+		if isExpired(ctx, ask) || types.IsSelfTrade(order, ask) {
+			continue
+		}
+
+		// the hidden reserve of iceberg orders refills the book at the same price
+		amount := ask.Amount.Add(k.orderReserveAmount(ctx, pairIndex, types.SellSide, ask.Id))
+		amount = sdk.MinInt(amount, order.Amount.Sub(affordable.Amount))
+		amount = sdk.MinInt(amount, budget.ToDec().QuoTruncate(ask.Price).TruncateInt())
+		if !amount.IsPositive() {
+			break
+		}
+
+		affordable.Amount = affordable.Amount.Add(amount)
+		affordable.Price = ask.Price
+		budget = budget.Sub(ask.Price.MulInt(amount).Ceil().TruncateInt())
+	}
+
+	return affordable
+}
+
+// FillBuyOrder fills a buy order against the sell order book of the pair, applying its self-trade
+// prevention mode to the asks of its own creator
+func (k Keeper) FillBuyOrder(
+	ctx sdk.Context,
+	pairIndex string,
+	order types.Order,
+	selfTradePrevention types.SelfTradePrevention,
+) (
+	remainingBuyOrder types.Order,
+	liquidated []types.Order,
+	purchase sdk.Int,
+	selfTrade types.SelfTrade,
+	filled bool,
+) {
+	var liquidatedList []types.Order
+	totalPurchase := sdk.ZeroInt()
+	remainingBuyOrder = order
+	selfTrade = types.NewSelfTrade()
+	matcher := k.pairMatcher(ctx, pairIndex, types.SellSide)
+
+	// liquidate as long as there is a match
+	for {
+		var stop bool
+		if matcher.SharesLevel() {
+			remainingBuyOrder, stop = k.preventLevelSelfTrades(
+				ctx,
+				pairIndex,
+				types.SellSide,
+				remainingBuyOrder,
+				selfTradePrevention,
+				&selfTrade,
+			)
+		} else {
+			remainingBuyOrder, stop = k.preventSelfTrade(
+				ctx,
+				pairIndex,
+				types.SellSide,
+				remainingBuyOrder,
+				selfTradePrevention,
+				&selfTrade,
+			)
+		}
+		if stop {
+			break
+		}
+
+		var match bool
+		var liquidations []types.Order
+		remainingBuyOrder, liquidations, match = k.liquidateLevel(
+			ctx,
+			pairIndex,
+			types.SellSide,
+			remainingBuyOrder,
+			matcher,
+		)
+		if !match {
+			break
+		}
+
+		for _, liquidation := range liquidations {
+			totalPurchase = totalPurchase.Add(liquidation.Amount)
+		}
+		liquidatedList = append(liquidatedList, liquidations...)
+
+		filled = remainingBuyOrder.Amount.IsZero()
+		if filled {
+			break
+		}
+	}
+
+	// the liquidations set the last price of the pair and update its candles
+	k.recordLastPrice(ctx, pairIndex, liquidatedList)
+	k.updateCandles(ctx, pairIndex, liquidatedList)
+
+	return remainingBuyOrder, liquidatedList, totalPurchase, selfTrade, filled
+}
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 	keepertest "interchange-nel/testutil/keeper"
 	"interchange-nel/testutil/nullify"
@@ -61,3 +63,295 @@ func TestSellOrderBookGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllSellOrderBook(ctx)),
 	)
 }
+
+type fillBuyRes struct {
+	Book       []types.Order
+	Remaining  types.Order
+	Liquidated []types.Order
+	Purchase   sdk.Int
+	Filled     bool
+}
+
+func TestSellOrderBookAppendOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	sellBook := types.NewSellOrderBook("foo", "bar")
+	sellBook.Index = testPairIndex
+
+	// prevent zero amount
+	creator, amount, price := GenOrder()
+	_, err := k.AppendSellOrder(ctx, sellBook, creator, sdk.ZeroInt(), price, types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrZeroAmount)
+
+	// prevent big amount
+	_, err = k.AppendSellOrder(ctx, sellBook, creator, types.DefaultMaxAmount.AddRaw(1), price, types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrMaxAmount)
+
+	// prevent zero price
+	_, err = k.AppendSellOrder(ctx, sellBook, creator, amount, sdk.ZeroDec(), types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrZeroPrice)
+
+	// prevent big price
+	_, err = k.AppendSellOrder(ctx, sellBook, creator, amount, types.DefaultMaxPrice.Add(sdk.OneDec()), types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrMaxPrice)
+
+	// the limits are the ones of the params
+	params := k.GetParams(ctx)
+	params.MaxAmount = amount
+	params.MaxPrice = price
+	k.SetParams(ctx, params)
+	_, err = k.AppendSellOrder(ctx, sellBook, creator, amount.AddRaw(1), price, types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrMaxAmount)
+	_, err = k.AppendSellOrder(ctx, sellBook, creator, amount, price.Add(sdk.OneDec()), types.OrderExpiry{}, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrMaxPrice)
+	k.SetParams(ctx, types.DefaultParams())
+
+	// can append sell orders
+	for i := 0; i < 20; i++ {
+		// append a new order
+		creator, amount, price := GenOrder()
+		newOrder := types.Order{
+			Id:      sellBook.Book.IdCount,
+			Creator: creator,
+			Amount:  amount,
+			Price:   price,
+		}
+		orderID, err := k.AppendSellOrder(ctx, sellBook, creator, amount, price, types.OrderExpiry{}, sdk.ZeroInt())
+
+		// assert checks
+		require.NoError(t, err)
+		require.Equal(t, newOrder.Id, orderID)
+		stored, found := k.GetOrder(ctx, testPairIndex, types.SellSide, price, orderID)
+		require.True(t, found)
+		require.True(t, newOrder.Amount.Equal(stored.Amount))
+		require.True(t, newOrder.Price.Equal(stored.Price))
+	}
+
+	// assert checks
+	orders := k.GetAllOrder(ctx, testPairIndex, types.SellSide)
+	require.Len(t, orders, 20)
+	require.True(t, sort.SliceIsSorted(orders, func(i, j int) bool {
+		return orders[i].Price.LT(orders[j].Price)
+	}))
+	rst, found := k.GetSellOrderBook(ctx, testPairIndex)
+	require.True(t, found)
+	require.Equal(t, int32(20), rst.Book.IdCount)
+}
+
+func simulateFillBuyOrder(
+	t *testing.T,
+	inputList []types.Order,
+	inputOrder types.Order,
+	expected fillBuyRes,
+) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, inputList)
+
+	remaining, liquidated, purchase, _, filled := k.FillBuyOrder(ctx, testPairIndex, inputOrder, types.CancelResting)
+
+	require.Equal(t, expected.Book, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+	require.Equal(t, expected.Remaining, remaining)
+	require.Equal(t, expected.Liquidated, liquidated)
+	require.Equal(t, expected.Purchase, purchase)
+	require.Equal(t, expected.Filled, filled)
+}
+
+func TestFillBuyOrder(t *testing.T) {
+	var inputBook []types.Order
+
+	// empty book
+	inputOrder := types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(30), Price: sdk.NewDec(10)}
+	expected := fillBuyRes{
+		Book:       []types.Order(nil),
+		Remaining:  inputOrder,
+		Liquidated: []types.Order(nil),
+		Purchase:   sdk.NewInt(0),
+		Filled:     false,
+	}
+	simulateFillBuyOrder(t, inputBook, inputOrder, expected)
+
+	// no match
+	inputBook = []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+	}
+	expected = fillBuyRes{
+		Book:       inputBook,
+		Remaining:  inputOrder,
+		Liquidated: []types.Order(nil),
+		Purchase:   sdk.NewInt(0),
+		Filled:     false,
+	}
+	simulateFillBuyOrder(t, inputBook, inputOrder, expected)
+
+	// first order liquidated, not filled
+	inputOrder = types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(60), Price: sdk.NewDec(18)}
+	expected = fillBuyRes{
+		Book: []types.Order{
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+			{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		},
+		Remaining: types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(30), Price: sdk.NewDec(18)},
+		Liquidated: []types.Order{
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		},
+		Purchase: sdk.NewInt(30),
+		Filled:   false,
+	}
+	simulateFillBuyOrder(t, inputBook, inputOrder, expected)
+
+	// filled with two order
+	inputOrder = types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(60), Price: sdk.NewDec(22)}
+	expected = fillBuyRes{
+		Book: []types.Order{
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(170), Price: sdk.NewDec(20)},
+			{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		},
+		Remaining: types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(0), Price: sdk.NewDec(22)},
+		Liquidated: []types.Order{
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(30), Price: sdk.NewDec(20)},
+		},
+		Purchase: sdk.NewInt(30 + 30),
+		Filled:   true,
+	}
+	simulateFillBuyOrder(t, inputBook, inputOrder, expected)
+
+	// not filled, sell order book liquidated
+	inputOrder = types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(300), Price: sdk.NewDec(30)}
+	expected = fillBuyRes{
+		Book:      []types.Order(nil),
+		Remaining: types.Order{Id: 10, Creator: MockAccount("10"), Amount: sdk.NewInt(20), Price: sdk.NewDec(30)},
+		Liquidated: []types.Order{
+			{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+			{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+			{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		},
+		Purchase: sdk.NewInt(30 + 200 + 50),
+		Filled:   false,
+	}
+	simulateFillBuyOrder(t, inputBook, inputOrder, expected)
+}
+
+func TestCanFillBuyOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+
+	// empty book
+	order := types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(30)}
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	inputBook := []types.Order{
+		{Id: 2, Creator: MockAccount("2"), Amount: sdk.NewInt(30), Price: sdk.NewDec(15)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+	}
+	setOrders(k, ctx, types.SellSide, inputBook)
+
+	// no match
+	order = types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(10)}
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	// filled with two orders
+	order = types.Order{Amount: sdk.NewInt(230), Price: sdk.NewDec(20)}
+	require.True(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	// not enough asks below the price
+	order = types.Order{Amount: sdk.NewInt(231), Price: sdk.NewDec(20)}
+	require.False(t, k.CanFillBuyOrder(ctx, testPairIndex, order, types.CancelResting))
+
+	// the book is left untouched
+	require.Equal(t, inputBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestAffordableBuyOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	order := types.Order{Creator: MockAccount("3"), Amount: sdk.NewInt(100)}
+
+	// the maximum quote buys 30@15 and 10@20
+	affordable := k.AffordableBuyOrder(ctx, testPairIndex, order, sdk.NewInt(30*15+10*20))
+	require.True(t, sdk.NewInt(40).Equal(affordable.Amount))
+	require.True(t, sdk.NewDec(20).Equal(affordable.Price))
+
+	// the amount of the last ask reached is rounded down to the quote left
+	affordable = k.AffordableBuyOrder(ctx, testPairIndex, order, sdk.NewInt(30*15+10*20-1))
+	require.True(t, sdk.NewInt(39).Equal(affordable.Amount))
+	require.True(t, sdk.NewDec(20).Equal(affordable.Price))
+
+	// the amount of the order bounds the asks reached
+	affordable = k.AffordableBuyOrder(ctx, testPairIndex, types.Order{Creator: MockAccount("3"), Amount: sdk.NewInt(20)}, sdk.NewInt(10000))
+	require.True(t, sdk.NewInt(20).Equal(affordable.Amount))
+	require.True(t, sdk.NewDec(15).Equal(affordable.Price))
+
+	// the asks of the creator are skipped
+	affordable = k.AffordableBuyOrder(ctx, testPairIndex, types.Order{Creator: MockAccount("2"), Amount: sdk.NewInt(100)}, sdk.NewInt(30*15+10*20))
+	require.True(t, sdk.NewInt(32).Equal(affordable.Amount))
+	require.True(t, sdk.NewDec(20).Equal(affordable.Price))
+
+	// the maximum quote affords none of the asks
+	affordable = k.AffordableBuyOrder(ctx, testPairIndex, order, sdk.NewInt(14))
+	require.True(t, affordable.Amount.IsZero())
+
+	// the book is left untouched
+	require.Equal(t, selfTradeSellBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestRecvMarketBuyOrderWithoutPrice(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	sellOrderBook := types.NewSellOrderBook("foo", "bar")
+	sellOrderBook.Index = testPairIndex
+	k.SetSellOrderBook(ctx, sellOrderBook)
+	setOrders(k, ctx, types.SellSide, selfTradeSellBook)
+
+	data := types.BuyOrderPacketData{
+		AmountDenom: "foo",
+		Amount:      sdk.NewInt(10),
+		PriceDenom:  "bar",
+		Price:       sdk.ZeroDec(),
+		OrderType:   types.MarketOrder,
+		TimeInForce: types.ImmediateOrCancel,
+		Buyer:       MockAccount("3"),
+		MaxQuote:    sdk.NewInt(14),
+	}
+	packet := channeltypes.Packet{SourcePort: "dex", SourceChannel: "channel-0"}
+
+	// the maximum quote must afford at least one unit of the lowest ask
+	_, _, err := k.OnRecvBuyOrderPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrMaxQuoteTooLow)
+
+	// fill-or-kill orders are rejected unless their maximum quote affords their whole amount
+	data.MaxQuote = sdk.NewInt(9 * 15)
+	data.TimeInForce = types.FillOrKill
+	_, _, err = k.OnRecvBuyOrderPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrFillOrKill)
+
+	// batch auctions clear at a single price the order could not bound
+	sellOrderBook.Rules.MatchingMode = types.BatchAuction
+	k.SetSellOrderBook(ctx, sellOrderBook)
+	data.TimeInForce = types.ImmediateOrCancel
+	_, _, err = k.OnRecvBuyOrderPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrBatchAuctionMaxQuote)
+
+	require.Equal(t, selfTradeSellBook, k.GetAllOrder(ctx, testPairIndex, types.SellSide))
+}
+
+func TestMatchesBuyOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+
+	// empty book
+	order := types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(30)}
+	require.False(t, k.MatchesBuyOrder(ctx, testPairIndex, order))
+
+	setOrders(k, ctx, types.SellSide, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(25)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(200), Price: sdk.NewDec(20)},
+	})
+
+	order = types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(19)}
+	require.False(t, k.MatchesBuyOrder(ctx, testPairIndex, order))
+
+	order = types.Order{Amount: sdk.NewInt(10), Price: sdk.NewDec(20)}
+	require.True(t, k.MatchesBuyOrder(ctx, testPairIndex, order))
+}
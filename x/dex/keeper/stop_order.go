@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+// GetStopOrderCount get the total number of stopOrder
+func (k Keeper) GetStopOrderCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.StopOrderCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetStopOrderCount set the total number of stopOrder
+func (k Keeper) SetStopOrderCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.StopOrderCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendStopOrder appends a stopOrder in the store with a new id and update the count
+func (k Keeper) AppendStopOrder(
+	ctx sdk.Context,
+	stopOrder types.StopOrder,
+) uint64 {
+	// Create the stopOrder
+	count := k.GetStopOrderCount(ctx)
+
+	// Set the ID of the appended value
+	stopOrder.Id = count
+
+	k.SetStopOrder(ctx, stopOrder)
+
+	// Update stopOrder count
+	k.SetStopOrderCount(ctx, count+1)
+
+	return count
+}
+
+// SetStopOrder set a specific stopOrder in the store and indexes it by stop price
+func (k Keeper) SetStopOrder(ctx sdk.Context, stopOrder types.StopOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderKey))
+	b := k.cdc.MustMarshal(&stopOrder)
+	store.Set(types.StopOrderIDKey(stopOrder.Id), b)
+
+	triggerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderTriggerKeyPrefix))
+	triggerStore.Set(types.StopOrderTriggerKey(
+		stopOrder.PairIndex(),
+		stopOrder.Side(),
+		stopOrder.StopPrice,
+		stopOrder.Id,
+	), types.StopOrderIDKey(stopOrder.Id))
+}
+
+// GetStopOrder returns a stopOrder from its id
+func (k Keeper) GetStopOrder(ctx sdk.Context, id uint64) (val types.StopOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderKey))
+	b := store.Get(types.StopOrderIDKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveStopOrder removes a stopOrder and its trigger index entry from the store
+func (k Keeper) RemoveStopOrder(ctx sdk.Context, id uint64) {
+	stopOrder, found := k.GetStopOrder(ctx, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderKey))
+	store.Delete(types.StopOrderIDKey(id))
+
+	triggerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderTriggerKeyPrefix))
+	triggerStore.Delete(types.StopOrderTriggerKey(
+		stopOrder.PairIndex(),
+		stopOrder.Side(),
+		stopOrder.StopPrice,
+		stopOrder.Id,
+	))
+}
+
+// GetAllStopOrder returns all stopOrder
+func (k Keeper) GetAllStopOrder(ctx sdk.Context) (list []types.StopOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StopOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PlaceStopOrder stores a stop order whose tokens are escrowed, until the last price of its pair crosses
+// its stop price. The timeout of the order packet is kept relative to the placement time
+func (k Keeper) PlaceStopOrder(
+	ctx sdk.Context,
+	stopOrder types.StopOrder,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	lastPrice, found := k.GetLastPrice(ctx, stopOrder.PairIndex())
+	if found && types.IsStopTriggered(stopOrder.Side(), stopOrder.StopPrice, lastPrice.Price) {
+		return 0, types.ErrStopPriceCrossed
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if timeoutTimestamp <= blockTime {
+		return 0, types.ErrInvalidPacketTimeout
+	}
+	stopOrder.RelativeTimeout = timeoutTimestamp - blockTime
+
+	return k.AppendStopOrder(ctx, stopOrder), nil
+}
+
+// GetTriggeredStopOrders returns at most limit stop orders of a pair triggered by its last price: the
+// sell stops with a stop price above or at the last price and the buy stops with a stop price below or
+// at it
+func (k Keeper) GetTriggeredStopOrders(
+	ctx sdk.Context,
+	pairIndex string,
+	lastPrice sdk.Dec,
+	limit int,
+) (list []types.StopOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StopOrderTriggerKeyPrefix))
+
+	collect := func(iterator sdk.Iterator) {
+		defer iterator.Close()
+
+		for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+			stopOrder, found := k.GetStopOrder(ctx, binary.BigEndian.Uint64(iterator.Value()))
+			if found {
+				list = append(list, stopOrder)
+			}
+		}
+	}
+
+	collect(store.Iterator(
+		types.StopOrderTriggerPriceKey(pairIndex, types.SellSide, lastPrice),
+		sdk.PrefixEndBytes(types.OrderBookSideKey(pairIndex, types.SellSide)),
+	))
+	collect(store.Iterator(
+		types.OrderBookSideKey(pairIndex, types.BuySide),
+		sdk.PrefixEndBytes(types.StopOrderTriggerPriceKey(pairIndex, types.BuySide, lastPrice)),
+	))
+
+	return list
+}
+
+// TriggerStopOrders sends the stop orders triggered by the last price of their pair to the
+// counterparty chain. The TriggeredStopOrdersLimit param caps the orders triggered per block, the others
+// are triggered in the next blocks
+func (k Keeper) TriggerStopOrders(ctx sdk.Context) {
+	limit := int(k.TriggeredStopOrdersLimit(ctx))
+	for _, lastPrice := range k.GetAllLastPrice(ctx) {
+		if limit == 0 {
+			return
+		}
+
+		stopOrders := k.GetTriggeredStopOrders(ctx, lastPrice.PairIndex, lastPrice.Price, limit)
+		limit -= len(stopOrders)
+
+		for _, stopOrder := range stopOrders {
+			if err := k.triggerStopOrder(ctx, stopOrder); err != nil {
+				k.Logger(ctx).Error(
+					"cannot trigger stop order",
+					"id", stopOrder.Id,
+					"error", err,
+				)
+			}
+		}
+	}
+}
+
+func (k Keeper) triggerStopOrder(ctx sdk.Context, stopOrder types.StopOrder) error {
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + stopOrder.RelativeTimeout
+
+	// the order is sent through the same packet path as the orders sent without stop price
+	cacheCtx, writeCache := ctx.CacheContext()
+	k.RemoveStopOrder(cacheCtx, stopOrder.Id)
+
+	var err error
+	if sellOrder := stopOrder.GetSellOrder(); sellOrder != nil {
+		err = k.TransmitSellOrderPacket(
+			cacheCtx,
+			*sellOrder,
+			stopOrder.Port,
+			stopOrder.ChannelID,
+			clienttypes.ZeroHeight(),
+			timeoutTimestamp,
+		)
+	} else {
+		err = k.TransmitBuyOrderPacket(
+			cacheCtx,
+			*stopOrder.GetBuyOrder(),
+			stopOrder.Port,
+			stopOrder.ChannelID,
+			clienttypes.ZeroHeight(),
+			timeoutTimestamp,
+		)
+	}
+	if err == nil {
+		writeCache()
+		return nil
+	}
+
+	// the order cannot be sent, its creator is refunded and the send error reported
+	cacheCtx, writeCache = ctx.CacheContext()
+	k.RemoveStopOrder(cacheCtx, stopOrder.Id)
+	if refundErr := k.RefundStopOrder(cacheCtx, stopOrder); refundErr != nil {
+		return refundErr
+	}
+	writeCache()
+
+	return err
+}
+
+// RefundStopOrder refunds the creator of a stop order with the tokens escrowed at placement
+func (k Keeper) RefundStopOrder(ctx sdk.Context, stopOrder types.StopOrder) error {
+	creator, err := sdk.AccAddressFromBech32(stopOrder.Creator)
+	if err != nil {
+		return err
+	}
+
+	if sellOrder := stopOrder.GetSellOrder(); sellOrder != nil {
+		return k.SafeMint(
+			ctx,
+			stopOrder.Port,
+			stopOrder.ChannelID,
+			creator,
+			sellOrder.AmountDenom,
+			sellOrder.Amount,
+		)
+	}
+
+	buyOrder := stopOrder.GetBuyOrder()
+	return k.SafeMint(
+		ctx,
+		stopOrder.Port,
+		stopOrder.ChannelID,
+		creator,
+		buyOrder.PriceDenom,
+		buyOrder.EscrowAmount(),
+	)
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+func sellStopOrder(creator string, stopPrice sdk.Dec) types.StopOrder {
+	return types.StopOrder{
+		Creator:   creator,
+		Port:      "dex",
+		ChannelID: "channel-0",
+		StopPrice: stopPrice,
+		Packet: &types.StopOrder_SellOrder{SellOrder: &types.SellOrderPacketData{
+			AmountDenom: "foo",
+			Amount:      sdk.NewInt(10),
+			PriceDenom:  "bar",
+			Price:       sdk.NewDec(1),
+		}},
+	}
+}
+
+func buyStopOrder(creator string, stopPrice sdk.Dec) types.StopOrder {
+	return types.StopOrder{
+		Creator:   creator,
+		Port:      "dex",
+		ChannelID: "channel-0",
+		StopPrice: stopPrice,
+		Packet: &types.StopOrder_BuyOrder{BuyOrder: &types.BuyOrderPacketData{
+			AmountDenom: "foo",
+			Amount:      sdk.NewInt(10),
+			PriceDenom:  "bar",
+			Price:       sdk.NewDec(100),
+		}},
+	}
+}
+
+func appendStopOrders(k *keeper.Keeper, ctx sdk.Context, list []types.StopOrder) []types.StopOrder {
+	for i := range list {
+		list[i].Id = k.AppendStopOrder(ctx, list[i])
+	}
+	return list
+}
+
+func TestStopOrderGet(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	list := appendStopOrders(k, ctx, []types.StopOrder{
+		sellStopOrder(MockAccount("0"), sdk.NewDec(10)),
+		buyStopOrder(MockAccount("1"), sdk.NewDec(20)),
+	})
+	require.Equal(t, uint64(2), k.GetStopOrderCount(ctx))
+
+	for i, stopOrder := range list {
+		require.Equal(t, uint64(i), stopOrder.Id)
+		rst, found := k.GetStopOrder(ctx, stopOrder.Id)
+		require.True(t, found)
+		require.Equal(t, stopOrder, rst)
+	}
+	require.ElementsMatch(t, list, k.GetAllStopOrder(ctx))
+
+	k.RemoveStopOrder(ctx, list[0].Id)
+	_, found := k.GetStopOrder(ctx, list[0].Id)
+	require.False(t, found)
+	require.Equal(t, []types.StopOrder{list[1]}, k.GetAllStopOrder(ctx))
+	require.Equal(t, uint64(2), k.GetStopOrderCount(ctx))
+}
+
+func TestGetTriggeredStopOrders(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	list := appendStopOrders(k, ctx, []types.StopOrder{
+		sellStopOrder(MockAccount("0"), sdk.NewDec(10)),
+		sellStopOrder(MockAccount("1"), sdk.NewDec(15)),
+		buyStopOrder(MockAccount("2"), sdk.NewDec(20)),
+		buyStopOrder(MockAccount("3"), sdk.NewDec(25)),
+	})
+
+	// nothing is triggered between the stop prices
+	require.Empty(t, k.GetTriggeredStopOrders(ctx, testPairIndex, sdk.NewDecWithPrec(175, 1), 10))
+
+	// sell stops are triggered when the price falls to their stop price
+	require.Equal(t, []types.StopOrder{list[1]},
+		k.GetTriggeredStopOrders(ctx, testPairIndex, sdk.NewDec(15), 10),
+	)
+	require.Equal(t, []types.StopOrder{list[0], list[1]},
+		k.GetTriggeredStopOrders(ctx, testPairIndex, sdk.NewDec(5), 10),
+	)
+
+	// buy stops are triggered when the price rises to their stop price
+	require.Equal(t, []types.StopOrder{list[2]},
+		k.GetTriggeredStopOrders(ctx, testPairIndex, sdk.NewDec(20), 10),
+	)
+	require.Equal(t, []types.StopOrder{list[2], list[3]},
+		k.GetTriggeredStopOrders(ctx, testPairIndex, sdk.NewDec(30), 10),
+	)
+
+	// bounded batches
+	require.Equal(t, []types.StopOrder{list[0]},
+		k.GetTriggeredStopOrders(ctx, testPairIndex, sdk.NewDec(5), 1),
+	)
+
+	// the stop orders of another pair are not triggered
+	require.Empty(t, k.GetTriggeredStopOrders(ctx, types.OrderBookIndex("dex", "channel-0", "bar", "foo"), sdk.NewDec(5), 10))
+
+	// removed stop orders are not triggered
+	k.RemoveStopOrder(ctx, list[0].Id)
+	require.Equal(t, []types.StopOrder{list[1]},
+		k.GetTriggeredStopOrders(ctx, testPairIndex, sdk.NewDec(5), 10),
+	)
+}
+
+func TestPlaceStopOrder(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	now := time.Unix(1_000, 0)
+	ctx = ctx.WithBlockTime(now)
+	timeout := uint64(now.Add(time.Minute).UnixNano())
+
+	id, err := k.PlaceStopOrder(ctx, sellStopOrder(MockAccount("0"), sdk.NewDec(10)), timeout)
+	require.NoError(t, err)
+	stopOrder, found := k.GetStopOrder(ctx, id)
+	require.True(t, found)
+	require.Equal(t, uint64(time.Minute.Nanoseconds()), stopOrder.RelativeTimeout)
+
+	_, err = k.PlaceStopOrder(ctx, sellStopOrder(MockAccount("0"), sdk.NewDec(10)), uint64(now.UnixNano()))
+	require.ErrorIs(t, err, types.ErrInvalidPacketTimeout)
+
+	// stop prices already crossed by the last price are rejected
+	k.SetLastPrice(ctx, types.LastPrice{PairIndex: testPairIndex, Price: sdk.NewDec(15)})
+	_, err = k.PlaceStopOrder(ctx, sellStopOrder(MockAccount("0"), sdk.NewDec(15)), timeout)
+	require.ErrorIs(t, err, types.ErrStopPriceCrossed)
+	_, err = k.PlaceStopOrder(ctx, buyStopOrder(MockAccount("0"), sdk.NewDec(15)), timeout)
+	require.ErrorIs(t, err, types.ErrStopPriceCrossed)
+	_, err = k.PlaceStopOrder(ctx, buyStopOrder(MockAccount("0"), sdk.NewDec(16)), timeout)
+	require.NoError(t, err)
+}
+
+func TestLastPriceRecordedByFill(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	setOrders(k, ctx, types.SellSide, []types.Order{
+		{Id: 0, Creator: MockAccount("0"), Amount: sdk.NewInt(50), Price: sdk.NewDec(10)},
+		{Id: 1, Creator: MockAccount("1"), Amount: sdk.NewInt(50), Price: sdk.NewDec(12)},
+	})
+
+	_, found := k.GetLastPrice(ctx, testPairIndex)
+	require.False(t, found)
+
+	// the price of the last match is the last price, even when partially filled
+	k.FillBuyOrder(ctx, testPairIndex, types.Order{
+		Id:      2,
+		Creator: MockAccount("2"),
+		Amount:  sdk.NewInt(80),
+		Price:   sdk.NewDec(15),
+	}, types.CancelResting)
+	lastPrice, found := k.GetLastPrice(ctx, testPairIndex)
+	require.True(t, found)
+	require.True(t, sdk.NewDec(12).Equal(lastPrice.Price))
+}
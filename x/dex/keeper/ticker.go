@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"interchange-nel/x/dex/types"
+)
+
+// GetTicker returns the ticker of a pair from the best orders of its local books and its last price
+func (k Keeper) GetTicker(ctx sdk.Context, index string) (val types.Ticker, found bool) {
+	_, buyFound := k.GetBuyOrderBook(ctx, index)
+	_, sellFound := k.GetSellOrderBook(ctx, index)
+	if !buyFound && !sellFound {
+		return val, false
+	}
+
+	val = types.Ticker{
+		Index:     index,
+		BestBid:   sdk.ZeroDec(),
+		BestAsk:   sdk.ZeroDec(),
+		Spread:    sdk.ZeroDec(),
+		MidPrice:  sdk.ZeroDec(),
+		LastPrice: sdk.ZeroDec(),
+	}
+
+	highestBid, bidFound := k.GetBestOrder(ctx, index, types.BuySide)
+	if bidFound {
+		val.BestBid = highestBid.Price
+	}
+	lowestAsk, askFound := k.GetBestOrder(ctx, index, types.SellSide)
+	if askFound {
+		val.BestAsk = lowestAsk.Price
+	}
+	if bidFound && askFound {
+		val.Spread = val.BestAsk.Sub(val.BestBid)
+		val.MidPrice = val.BestAsk.Add(val.BestBid).QuoInt64(2)
+	}
+
+	if lastPrice, found := k.GetLastPrice(ctx, index); found {
+		val.LastPrice = lastPrice.Price
+		val.Timestamp = lastPrice.Timestamp
+	}
+
+	return val, true
+}
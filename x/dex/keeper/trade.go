@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"interchange-nel/x/dex/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTradeCount get the total number of trade
+func (k Keeper) GetTradeCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.TradeCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetTradeCount set the total number of trade
+func (k Keeper) SetTradeCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.TradeCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendTrade appends a trade in the store with a new id and update the count
+func (k Keeper) AppendTrade(
+	ctx sdk.Context,
+	trade types.Trade,
+) uint64 {
+	// Create the trade
+	count := k.GetTradeCount(ctx)
+
+	// Set the ID of the appended value
+	trade.Id = count
+
+	k.SetTrade(ctx, trade)
+
+	// Update trade count
+	k.SetTradeCount(ctx, count+1)
+
+	return count
+}
+
+// SetTrade set a specific trade in the store and indexes it by pair and by maker and taker
+func (k Keeper) SetTrade(ctx sdk.Context, trade types.Trade) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeKey))
+	b := k.cdc.MustMarshal(&trade)
+	store.Set(types.TradeIDKey(trade.Id), b)
+
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradePairKeyPrefix))
+	pairStore.Set(append(types.TradePairKey(trade.PairIndex), types.TradeIDKey(trade.Id)...), []byte{})
+
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeAccountKeyPrefix))
+	for _, account := range []string{trade.Maker, trade.Taker} {
+		accountStore.Set(append(types.TradeAccountKey(account), types.TradeIDKey(trade.Id)...), []byte{})
+	}
+}
+
+// GetTrade returns a trade from its id
+func (k Keeper) GetTrade(ctx sdk.Context, id uint64) (val types.Trade, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeKey))
+	b := store.Get(types.TradeIDKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTrade removes a trade and its index entries from the store
+func (k Keeper) RemoveTrade(ctx sdk.Context, id uint64) {
+	trade, found := k.GetTrade(ctx, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeKey))
+	store.Delete(types.TradeIDKey(id))
+
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradePairKeyPrefix))
+	pairStore.Delete(append(types.TradePairKey(trade.PairIndex), types.TradeIDKey(id)...))
+
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeAccountKeyPrefix))
+	for _, account := range []string{trade.Maker, trade.Taker} {
+		accountStore.Delete(append(types.TradeAccountKey(account), types.TradeIDKey(id)...))
+	}
+}
+
+// GetAllTrade returns all trade
+func (k Keeper) GetAllTrade(ctx sdk.Context) (list []types.Trade) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Trade
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RecordTrades records the fills of an incoming order against the resting orders of a pair as trades,
+// executed at the given height and time
+func (k Keeper) RecordTrades(
+	ctx sdk.Context,
+	pairIndex string,
+	taker string,
+	packetSequence uint64,
+	height int64,
+	timestamp uint64,
+	fills []types.Order,
+) {
+	for _, fill := range fills {
+		k.AppendTrade(ctx, types.Trade{
+			PairIndex:      pairIndex,
+			MakerOrderId:   fill.Id,
+			Maker:          fill.Creator,
+			Taker:          taker,
+			Price:          fill.Price,
+			Amount:         fill.Amount,
+			Height:         height,
+			Timestamp:      timestamp,
+			PacketSequence: packetSequence,
+		})
+	}
+}
+
+// PruneTrades removes the trades older than the trade retention, in the order they were recorded. The
+// trades are kept forever if the retention is zero
+func (k Keeper) PruneTrades(ctx sdk.Context) {
+	retention := k.TradeRetention(ctx)
+	if retention <= 0 {
+		return
+	}
+
+	blockTime := ctx.BlockTime().UnixNano()
+	if blockTime <= retention.Nanoseconds() {
+		return
+	}
+	expiry := uint64(blockTime - retention.Nanoseconds())
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TradeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	// collect the expired trades first as removing them modifies the store
+	var expired []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var trade types.Trade
+		k.cdc.MustUnmarshal(iterator.Value(), &trade)
+		if trade.Timestamp > expiry {
+			break
+		}
+		expired = append(expired, trade.Id)
+	}
+	iterator.Close()
+
+	for _, id := range expired {
+		k.RemoveTrade(ctx, id)
+	}
+}
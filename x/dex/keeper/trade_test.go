@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "interchange-nel/testutil/keeper"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+// tradeFills are the fills of an incoming order against two makers
+var tradeFills = []types.Order{
+	{Id: 3, Creator: MockAccount("0"), Amount: sdk.NewInt(10), Price: sdk.NewDec(15)},
+	{Id: 5, Creator: MockAccount("1"), Amount: sdk.NewInt(20), Price: sdk.NewDec(16)},
+}
+
+func recordTestTrades(k *keeper.Keeper, ctx sdk.Context, pairIndex string, taker string, timestamp uint64) {
+	k.RecordTrades(ctx, pairIndex, taker, 7, 42, timestamp, tradeFills)
+}
+
+func TestTradeGet(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	recordTestTrades(k, ctx, testPairIndex, MockAccount("2"), 100)
+	require.Equal(t, uint64(2), k.GetTradeCount(ctx))
+
+	for i, fill := range tradeFills {
+		trade, found := k.GetTrade(ctx, uint64(i))
+		require.True(t, found)
+		require.Equal(t, types.Trade{
+			Id:             uint64(i),
+			PairIndex:      testPairIndex,
+			MakerOrderId:   fill.Id,
+			Maker:          fill.Creator,
+			Taker:          MockAccount("2"),
+			Price:          fill.Price,
+			Amount:         fill.Amount,
+			Height:         42,
+			Timestamp:      100,
+			PacketSequence: 7,
+		}, trade)
+	}
+	require.Len(t, k.GetAllTrade(ctx), 2)
+
+	k.RemoveTrade(ctx, 0)
+	_, found := k.GetTrade(ctx, 0)
+	require.False(t, found)
+	require.Len(t, k.GetAllTrade(ctx), 1)
+	require.Equal(t, uint64(2), k.GetTradeCount(ctx))
+}
+
+func TestPruneTrades(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	params := types.DefaultParams()
+	params.TradeRetention = time.Hour
+	k.SetParams(ctx, params)
+	start := time.Unix(1_000_000, 0)
+	recordTestTrades(k, ctx, testPairIndex, MockAccount("2"), uint64(start.UnixNano()))
+	recordTestTrades(k, ctx, testPairIndex, MockAccount("2"), uint64(start.Add(time.Minute).UnixNano()))
+
+	// no trade is older than the retention
+	k.PruneTrades(ctx.WithBlockTime(start.Add(time.Hour - time.Second)))
+	require.Len(t, k.GetAllTrade(ctx), 4)
+
+	// the first order's trades expire first
+	k.PruneTrades(ctx.WithBlockTime(start.Add(time.Hour)))
+	trades := k.GetAllTrade(ctx)
+	require.Len(t, trades, 2)
+	require.Equal(t, uint64(2), trades[0].Id)
+
+	// trades are kept forever without retention
+	params.TradeRetention = 0
+	k.SetParams(ctx, params)
+	k.PruneTrades(ctx.WithBlockTime(start.Add(24 * time.Hour)))
+	require.Len(t, k.GetAllTrade(ctx), 2)
+}
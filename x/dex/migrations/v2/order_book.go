@@ -0,0 +1,38 @@
+// Package v2 holds the order books of the consensus version 2 of the dex module, which stored their
+// orders in the book itself with int32 amounts and prices. They are only decoded by the store migration
+// to the version 3.
+package v2
+
+import "github.com/gogo/protobuf/proto"
+
+type OrderBook struct {
+	IdCount int32    `protobuf:"varint,1,opt,name=idCount,proto3" json:"idCount,omitempty"`
+	Orders  []*Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (m *OrderBook) Reset()         { *m = OrderBook{} }
+func (m *OrderBook) String() string { return proto.CompactTextString(m) }
+func (*OrderBook) ProtoMessage()    {}
+
+type Order struct {
+	Id      int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  int32  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price   int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+
+// PairOrderBook is the layout shared by the sell and buy order books of a pair
+type PairOrderBook struct {
+	Index       string     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	AmountDenom string     `protobuf:"bytes,2,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	PriceDenom  string     `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Book        *OrderBook `protobuf:"bytes,4,opt,name=book,proto3" json:"book,omitempty"`
+}
+
+func (m *PairOrderBook) Reset()         { *m = PairOrderBook{} }
+func (m *PairOrderBook) String() string { return proto.CompactTextString(m) }
+func (*PairOrderBook) ProtoMessage()    {}
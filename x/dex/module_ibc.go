@@ -164,7 +164,18 @@ func (am AppModule) OnRecvPacket(
 			),
 		)
 	case *types.DexPacketData_SellOrderPacket:
-		packetAck, err := am.keeper.OnRecvSellOrderPacket(ctx, modulePacket, *packet.SellOrderPacket)
+		packetAck, queued, err := am.keeper.OnRecvSellOrderPacket(ctx, modulePacket, *packet.SellOrderPacket)
+		if queued {
+			// the acknowledgement is written when the batch auction of the pair clears
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBatchAuctionQueued,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyPacketSequence, fmt.Sprintf("%d", modulePacket.Sequence)),
+				),
+			)
+			return nil
+		}
 		if err != nil {
 			ack = channeltypes.NewErrorAcknowledgement(err.Error())
 		} else {
@@ -183,7 +194,18 @@ func (am AppModule) OnRecvPacket(
 			),
 		)
 	case *types.DexPacketData_BuyOrderPacket:
-		packetAck, err := am.keeper.OnRecvBuyOrderPacket(ctx, modulePacket, *packet.BuyOrderPacket)
+		packetAck, queued, err := am.keeper.OnRecvBuyOrderPacket(ctx, modulePacket, *packet.BuyOrderPacket)
+		if queued {
+			// the acknowledgement is written when the batch auction of the pair clears
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBatchAuctionQueued,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyPacketSequence, fmt.Sprintf("%d", modulePacket.Sequence)),
+				),
+			)
+			return nil
+		}
 		if err != nil {
 			ack = channeltypes.NewErrorAcknowledgement(err.Error())
 		} else {
@@ -207,7 +229,8 @@ func (am AppModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(errMsg)
 	}
 
-	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution, except for the
+	// orders queued for batch auctions.
 	return ack
 }
 
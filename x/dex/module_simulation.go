@@ -32,6 +32,14 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgCancelBuyOrder int = 100
 
+	opWeightMsgCancelStopOrder = "op_weight_msg_cancel_stop_order"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelStopOrder int = 100
+
+	opWeightMsgAmendOrder = "op_weight_msg_amend_order"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAmendOrder int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -42,22 +50,21 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 		accs[i] = acc.Address.String()
 	}
 	dexGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: dexsimulation.GenParams(simState.Rand),
 		PortId: types.PortID,
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&dexGenesis)
 }
 
-// ProposalContents doesn't return any content functions for governance proposals
-func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
-	return nil
+// ProposalContents returns the content functions of the proposals halting, resuming and delisting pairs
+func (am AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return dexsimulation.ProposalContents(am.keeper)
 }
 
 // RandomizedParams creates randomized  param changes for the simulator
-func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
-
-	return []simtypes.ParamChange{}
+func (am AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
+	return dexsimulation.ParamChanges(r)
 }
 
 // RegisterStoreDecoder registers a decoder
@@ -89,6 +96,28 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		dexsimulation.SimulateMsgCancelBuyOrder(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgCancelStopOrder int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCancelStopOrder, &weightMsgCancelStopOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelStopOrder = defaultWeightMsgCancelStopOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelStopOrder,
+		dexsimulation.SimulateMsgCancelStopOrder(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAmendOrder int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAmendOrder, &weightMsgAmendOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgAmendOrder = defaultWeightMsgAmendOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAmendOrder,
+		dexsimulation.SimulateMsgAmendOrder(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
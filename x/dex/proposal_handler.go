@@ -0,0 +1,31 @@
+package dex
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+// NewProposalHandler creates a new governance Handler for the dex proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdatePairRulesProposal:
+			return k.SetPairRules(ctx, c.PairIndex, c.Rules)
+
+		case *types.HaltPairProposal:
+			return k.SetPairHalted(ctx, c.PairIndex, true)
+
+		case *types.ResumePairProposal:
+			return k.SetPairHalted(ctx, c.PairIndex, false)
+
+		case *types.DelistPairProposal:
+			return k.DelistPair(ctx, c.PairIndex)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized dex proposal content type: %T", c)
+		}
+	}
+}
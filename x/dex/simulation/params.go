@@ -0,0 +1,107 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"interchange-nel/x/dex/types"
+)
+
+// GenFeeRate returns a randomized fee rate up to 1%
+func GenFeeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 4)
+}
+
+// GenFeeDistribution returns randomized shares of the collected fees summing up to one
+func GenFeeDistribution(r *rand.Rand) types.FeeDistribution {
+	communityPool := sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+	burn := sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+
+	return types.NewFeeDistribution(sdk.OneDec().Sub(communityPool).Sub(burn), communityPool, burn)
+}
+
+// GenMaxAmount returns a randomized maximum order amount between 10^18 and 10^30
+func GenMaxAmount(r *rand.Rand) sdk.Int {
+	return sdk.NewIntWithDecimal(1, 18+r.Intn(13))
+}
+
+// GenMaxPrice returns a randomized maximum order price between 10^18 and 10^30
+func GenMaxPrice(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18+r.Intn(13)))
+}
+
+// GenTriggeredStopOrdersLimit returns a randomized number of stop orders triggered per block
+func GenTriggeredStopOrdersLimit(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(200))
+}
+
+// GenExpiredOrdersPruneLimit returns a randomized number of expired orders pruned per block
+func GenExpiredOrdersPruneLimit(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(200))
+}
+
+// GenParams returns randomized params for the genesis of the simulation, the trade retention and the
+// candle horizon keeping their defaults
+func GenParams(r *rand.Rand) types.Params {
+	return types.NewParams(
+		types.DefaultTradeRetention,
+		types.DefaultCandleHorizon,
+		GenFeeRate(r),
+		GenFeeRate(r),
+		GenFeeDistribution(r),
+		GenMaxAmount(r),
+		GenMaxPrice(r),
+		GenTriggeredStopOrdersLimit(r),
+		GenExpiredOrdersPruneLimit(r),
+	)
+}
+
+// ParamChanges defines the parameters that can be modified by param change proposals on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMakerFeeRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFeeRate(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTakerFeeRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFeeRate(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFeeDistribution),
+			func(r *rand.Rand) string {
+				feeDistribution := GenFeeDistribution(r)
+				return fmt.Sprintf(
+					"{\"stakers\":\"%s\",\"communityPool\":\"%s\",\"burn\":\"%s\"}",
+					feeDistribution.Stakers,
+					feeDistribution.CommunityPool,
+					feeDistribution.Burn,
+				)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxAmount),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxAmount(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxPrice),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxPrice(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTriggeredStopOrdersLimit),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenTriggeredStopOrdersLimit(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyExpiredOrdersPruneLimit),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenExpiredOrdersPruneLimit(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"interchange-nel/x/dex/keeper"
+	"interchange-nel/x/dex/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightSubmitHaltPairProposal   = "op_weight_submit_halt_pair_proposal"
+	OpWeightSubmitResumePairProposal = "op_weight_submit_resume_pair_proposal"
+	OpWeightSubmitDelistPairProposal = "op_weight_submit_delist_pair_proposal"
+
+	DefaultWeightHaltPairProposal   int = 5
+	DefaultWeightResumePairProposal int = 5
+	DefaultWeightDelistPairProposal int = 1
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitHaltPairProposal,
+			DefaultWeightHaltPairProposal,
+			SimulatePairProposalContent(k, func(title, description, pairIndex string) simtypes.Content {
+				return types.NewHaltPairProposal(title, description, pairIndex)
+			}),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitResumePairProposal,
+			DefaultWeightResumePairProposal,
+			SimulatePairProposalContent(k, func(title, description, pairIndex string) simtypes.Content {
+				return types.NewResumePairProposal(title, description, pairIndex)
+			}),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitDelistPairProposal,
+			DefaultWeightDelistPairProposal,
+			SimulatePairProposalContent(k, func(title, description, pairIndex string) simtypes.Content {
+				return types.NewDelistPairProposal(title, description, pairIndex)
+			}),
+		),
+	}
+}
+
+// SimulatePairProposalContent generates the content of a proposal targeting a random pair with an order
+// book on the chain, no content if there is none
+func SimulatePairProposalContent(
+	k keeper.Keeper,
+	newContent func(title, description, pairIndex string) simtypes.Content,
+) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		var pairIndexes []string
+		for _, book := range k.GetAllSellOrderBook(ctx) {
+			pairIndexes = append(pairIndexes, book.Index)
+		}
+		for _, book := range k.GetAllBuyOrderBook(ctx) {
+			pairIndexes = append(pairIndexes, book.Index)
+		}
+		if len(pairIndexes) == 0 {
+			return nil
+		}
+
+		return newContent(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pairIndexes[r.Intn(len(pairIndexes))],
+		)
+	}
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ErrBatchAuctionFillOrKill = errors.New("fill-or-kill orders cannot take part in batch auctions")
+	ErrBatchAuctionMaxQuote   = errors.New("market buy orders without protection price cannot take part in batch auctions")
+)
+
+// AuctionLevel is the amount an order of a batch auction buys or sells at its price
+type AuctionLevel struct {
+	Price  sdk.Dec
+	Amount sdk.Int
+}
+
+// ClearingPrice returns the uniform price maximising the volume executed between bids and asks, bids
+// buying at or below their price and asks selling at or above theirs. Ties are broken by the smallest
+// imbalance between the bid and ask volumes, then by the lowest price. The volume is zero if the bids
+// and asks do not cross
+func ClearingPrice(bids []AuctionLevel, asks []AuctionLevel) (price sdk.Dec, volume sdk.Int) {
+	bids = sortedLevels(bids)
+	asks = sortedLevels(asks)
+
+	// the clearing price is one of the prices of the orders, market sells without price excepted
+	var prices []sdk.Dec
+	totalDemand := sdk.ZeroInt()
+	for _, bid := range bids {
+		prices = append(prices, bid.Price)
+		totalDemand = totalDemand.Add(bid.Amount)
+	}
+	for _, ask := range asks {
+		if ask.Price.IsPositive() {
+			prices = append(prices, ask.Price)
+		}
+	}
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+
+	// sweep the prices upwards: the supply grows with the asks reached and the demand shrinks with the
+	// bids left behind
+	price, volume = sdk.ZeroDec(), sdk.ZeroInt()
+	imbalance := sdk.ZeroInt()
+	supply, demandBelow := sdk.ZeroInt(), sdk.ZeroInt()
+	askIndex, bidIndex := 0, 0
+	for _, p := range prices {
+		for ; askIndex < len(asks) && asks[askIndex].Price.LTE(p); askIndex++ {
+			supply = supply.Add(asks[askIndex].Amount)
+		}
+		for ; bidIndex < len(bids) && bids[bidIndex].Price.LT(p); bidIndex++ {
+			demandBelow = demandBelow.Add(bids[bidIndex].Amount)
+		}
+		demand := totalDemand.Sub(demandBelow)
+
+		executed := sdk.MinInt(demand, supply)
+		executedImbalance := demand.Sub(supply).Abs()
+		if !executed.IsPositive() {
+			continue
+		}
+		if executed.GT(volume) || (executed.Equal(volume) && executedImbalance.LT(imbalance)) {
+			price, volume, imbalance = p, executed, executedImbalance
+		}
+	}
+
+	return price, volume
+}
+
+// sortedLevels returns a copy of auction levels sorted by increasing price
+func sortedLevels(levels []AuctionLevel) []AuctionLevel {
+	sorted := make([]AuctionLevel, len(levels))
+	copy(sorted, levels)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Price.LT(sorted[j].Price)
+	})
+
+	return sorted
+}
@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/batch_auction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuctionOrder is an order packet queued for the batch auction of its pair, its acknowledgement is
+// written once the auction clears at the end of the block
+type AuctionOrder struct {
+	Id        uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PairIndex string       `protobuf:"bytes,2,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Packet    types.Packet `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *AuctionOrder) Reset()         { *m = AuctionOrder{} }
+func (m *AuctionOrder) String() string { return proto.CompactTextString(m) }
+func (*AuctionOrder) ProtoMessage()    {}
+func (*AuctionOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e4df3a56aa3c42a, []int{0}
+}
+func (m *AuctionOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionOrder.Merge(m, src)
+}
+func (m *AuctionOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionOrder proto.InternalMessageInfo
+
+func (m *AuctionOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuctionOrder) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *AuctionOrder) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func init() {
+	proto.RegisterType((*AuctionOrder)(nil), "interchangenel.dex.AuctionOrder")
+}
+
+func init() { proto.RegisterFile("dex/batch_auction.proto", fileDescriptor_0e4df3a56aa3c42a) }
+
+var fileDescriptor_0e4df3a56aa3c42a = []byte{
+	// 245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x49, 0xad, 0xd0,
+	0x4f, 0x4a, 0x2c, 0x49, 0xce, 0x88, 0x4f, 0x2c, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28,
+	0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b, 0x4f,
+	0xcd, 0x4b, 0xcd, 0xd1, 0x4b, 0x49, 0xad, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb,
+	0x83, 0x58, 0x10, 0x95, 0x52, 0x8a, 0x99, 0x49, 0xc9, 0xfa, 0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x20,
+	0xd5, 0x79, 0xa9, 0x39, 0xfa, 0x65, 0x86, 0x30, 0x26, 0x44, 0x89, 0x52, 0x39, 0x17, 0x8f, 0x23,
+	0xc4, 0x74, 0xff, 0xa2, 0x94, 0xd4, 0x22, 0x21, 0x3e, 0x2e, 0xa6, 0xcc, 0x14, 0x09, 0x46, 0x05,
+	0x46, 0x0d, 0x96, 0x20, 0xa6, 0xcc, 0x14, 0x21, 0x19, 0x2e, 0xce, 0x82, 0xc4, 0xcc, 0x22, 0xcf,
+	0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x84, 0x80, 0x90, 0x25, 0x17,
+	0x5b, 0x41, 0x62, 0x72, 0x76, 0x6a, 0x89, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x5e,
+	0x66, 0x52, 0xb2, 0x1e, 0xc8, 0x46, 0x3d, 0x98, 0x35, 0x65, 0x86, 0x7a, 0x01, 0x60, 0x25, 0x4e,
+	0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x35, 0x38, 0x99, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
+	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
+	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x34, 0x92, 0xff, 0x74, 0x41, 0x8e, 0xae, 0xd0, 0x07, 0x05, 0x45,
+	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xd9, 0xc6, 0x80, 0x01, 0x00, 0xf8, 0x56, 0x9f,
+	0x48, 0x1e, 0x01, 0x00, 0x00,
+}
+
+func (m *AuctionOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBatchAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintBatchAuction(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBatchAuction(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatchAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatchAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuctionOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBatchAuction(uint64(m.Id))
+	}
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovBatchAuction(uint64(l))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovBatchAuction(uint64(l))
+	return n
+}
+
+func sovBatchAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatchAuction(x uint64) (n int) {
+	return sovBatchAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuctionOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatchAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatchAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatchAuction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatchAuction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBatchAuction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBatchAuction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBatchAuction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBatchAuction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatchAuction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBatchAuction = fmt.Errorf("proto: unexpected end of group")
+)
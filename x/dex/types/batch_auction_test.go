@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func auctionLevel(price int64, amount int64) types.AuctionLevel {
+	return types.AuctionLevel{Price: sdk.NewDec(price), Amount: sdk.NewInt(amount)}
+}
+
+func TestClearingPrice(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		bids   []types.AuctionLevel
+		asks   []types.AuctionLevel
+		price  sdk.Dec
+		volume sdk.Int
+	}{
+		{
+			desc:   "no cross",
+			bids:   []types.AuctionLevel{auctionLevel(10, 50)},
+			asks:   []types.AuctionLevel{auctionLevel(11, 50)},
+			price:  sdk.ZeroDec(),
+			volume: sdk.ZeroInt(),
+		},
+		{
+			desc:   "empty side",
+			bids:   []types.AuctionLevel{auctionLevel(10, 50)},
+			price:  sdk.ZeroDec(),
+			volume: sdk.ZeroInt(),
+		},
+		{
+			desc:   "maximum volume",
+			bids:   []types.AuctionLevel{auctionLevel(12, 30), auctionLevel(11, 40), auctionLevel(9, 100)},
+			asks:   []types.AuctionLevel{auctionLevel(8, 20), auctionLevel(10, 50), auctionLevel(12, 100)},
+			price:  sdk.NewDec(10),
+			volume: sdk.NewInt(70),
+		},
+		{
+			desc:   "minimum imbalance",
+			bids:   []types.AuctionLevel{auctionLevel(12, 50), auctionLevel(11, 10), auctionLevel(10, 20)},
+			asks:   []types.AuctionLevel{auctionLevel(10, 50)},
+			price:  sdk.NewDec(12),
+			volume: sdk.NewInt(50),
+		},
+		{
+			desc:   "lowest price",
+			bids:   []types.AuctionLevel{auctionLevel(12, 50)},
+			asks:   []types.AuctionLevel{auctionLevel(10, 50)},
+			price:  sdk.NewDec(10),
+			volume: sdk.NewInt(50),
+		},
+		{
+			desc:   "market asks",
+			bids:   []types.AuctionLevel{auctionLevel(12, 30), auctionLevel(11, 40)},
+			asks:   []types.AuctionLevel{auctionLevel(0, 60)},
+			price:  sdk.NewDec(11),
+			volume: sdk.NewInt(60),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			price, volume := types.ClearingPrice(tc.bids, tc.asks)
+			require.True(t, tc.price.Equal(price), "price %s", price)
+			require.True(t, tc.volume.Equal(volume), "volume %s", volume)
+		})
+	}
+}
@@ -8,90 +8,3 @@ func NewBuyOrderBook(amountDenom string, priceDenom string) BuyOrderBook {
 		Book:        &book,
 	}
 }
-
-func (b *BuyOrderBook) AppendOrder(creator string, amount int32, price int32) (int32, error) {
-	return b.Book.appendOrder(creator, amount, price, Increasing)
-}
-
-func (b *BuyOrderBook) FillSellOrder(order Order) (
-	remainingSellOrder Order,
-	liquidated []Order,
-	gain int32,
-	filled bool,
-) {
-	var liquidatedList []Order
-	totalGain := int32(0)
-	remainingSellOrder = order
-
-	// liquidate as long as there is match
-	for {
-		var match bool
-		var liquidation Order
-		remainingSellOrder, liquidation, gain, match, filled = b.LiquidateFromSellOrder(
-			remainingSellOrder,
-		)
-		if !match {
-			break
-		}
-
-		// update gains
-		totalGain += gain
-
-		// update liquidated
-		liquidatedList = append(liquidatedList, liquidation)
-
-		if filled {
-			break
-		}
-	}
-
-	return remainingSellOrder, liquidatedList, totalGain, filled
-}
-
-func (b *BuyOrderBook) LiquidateFromSellOrder(order Order) (
-	remainingSellOrder Order,
-	liquidatedBuyOrder Order,
-	gain int32,
-	match bool,
-	filled bool,
-) {
-	remainingSellOrder = order
-
-	// no match if no order
-	orderCount := len(b.Book.Orders)
-	if orderCount == 0 {
-		return order, liquidatedBuyOrder, gain, false, false
-	}
-
-	// check if match
-	highestBid := b.Book.Orders[orderCount-1]
-	if order.Price > highestBid.Price {
-		return order, liquidatedBuyOrder, gain, false, false
-	}
-
-	liquidatedBuyOrder = *highestBid
-
-	// check if sell order can be entirely filled
-	if highestBid.Amount >= order.Amount {
-		remainingSellOrder.Amount = 0
-		liquidatedBuyOrder.Amount = order.Amount
-		gain = order.Amount * highestBid.Price
-
-		// remove highest bid if it has been entirely liquidated
-		highestBid.Amount -= order.Amount
-		if highestBid.Amount == 0 {
-			b.Book.Orders = b.Book.Orders[:orderCount-1]
-		} else {
-			b.Book.Orders[orderCount-1] = highestBid
-		}
-
-		return remainingSellOrder, liquidatedBuyOrder, gain, true, true
-	}
-
-	// not entirely filled
-	gain = highestBid.Amount * highestBid.Price
-	b.Book.Orders = b.Book.Orders[:orderCount-1]
-	remainingSellOrder.Amount -= highestBid.Amount
-
-	return remainingSellOrder, liquidatedBuyOrder, gain, true, false
-}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -27,6 +28,12 @@ type BuyOrderBook struct {
 	AmountDenom string     `protobuf:"bytes,2,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
 	PriceDenom  string     `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
 	Book        *OrderBook `protobuf:"bytes,4,opt,name=book,proto3" json:"book,omitempty"`
+	// port and channel escrowing the orders of the book on this chain
+	Port    string    `protobuf:"bytes,5,opt,name=port,proto3" json:"port,omitempty"`
+	Channel string    `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Rules   PairRules `protobuf:"bytes,7,opt,name=rules,proto3" json:"rules"`
+	// halted books reject the new orders of the pair until a governance proposal resumes it
+	Halted bool `protobuf:"varint,8,opt,name=halted,proto3" json:"halted,omitempty"`
 }
 
 func (m *BuyOrderBook) Reset()         { *m = BuyOrderBook{} }
@@ -90,6 +97,34 @@ func (m *BuyOrderBook) GetBook() *OrderBook {
 	return nil
 }
 
+func (m *BuyOrderBook) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *BuyOrderBook) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *BuyOrderBook) GetRules() PairRules {
+	if m != nil {
+		return m.Rules
+	}
+	return PairRules{}
+}
+
+func (m *BuyOrderBook) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*BuyOrderBook)(nil), "interchangenel.dex.BuyOrderBook")
 }
@@ -97,21 +132,27 @@ func init() {
 func init() { proto.RegisterFile("dex/buy_order_book.proto", fileDescriptor_4e7e0a35566635fd) }
 
 var fileDescriptor_4e7e0a35566635fd = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x49, 0xad, 0xd0,
-	0x4f, 0x2a, 0xad, 0x8c, 0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0x8a, 0x4f, 0xca, 0xcf, 0xcf, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b,
-	0x4f, 0xcd, 0x4b, 0xcd, 0xd1, 0x4b, 0x49, 0xad, 0x90, 0xe2, 0x07, 0xa9, 0x06, 0xab, 0x84, 0x28,
-	0x52, 0x9a, 0xc9, 0xc8, 0xc5, 0xe3, 0x54, 0x5a, 0xe9, 0x0f, 0x12, 0x72, 0xca, 0xcf, 0xcf, 0x16,
-	0x12, 0xe1, 0x62, 0xcd, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82,
-	0x70, 0x84, 0x14, 0xb8, 0xb8, 0x13, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x5c, 0x52, 0xf3, 0xf2, 0x73,
-	0x25, 0x98, 0xc0, 0x72, 0xc8, 0x42, 0x42, 0x72, 0x5c, 0x5c, 0x05, 0x45, 0x99, 0xc9, 0xa9, 0x10,
-	0x05, 0xcc, 0x60, 0x05, 0x48, 0x22, 0x42, 0x86, 0x5c, 0x2c, 0x20, 0xb7, 0x49, 0xb0, 0x28, 0x30,
-	0x6a, 0x70, 0x1b, 0xc9, 0xea, 0x61, 0x3a, 0x4e, 0x0f, 0xee, 0x88, 0x20, 0xb0, 0x52, 0x27, 0xd3,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x92, 0x46, 0xd2, 0xad, 0x9b, 0x97,
-	0x9a, 0xa3, 0x5f, 0xa1, 0x0f, 0xf2, 0x58, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x67,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x07, 0x8a, 0x7b, 0x1a, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x4a, 0xf3, 0x40,
+	0x10, 0xc0, 0xb3, 0xfd, 0xd2, 0x3f, 0xdf, 0x56, 0x10, 0x96, 0x22, 0x4b, 0xc5, 0x35, 0x78, 0xea,
+	0xc5, 0x04, 0x15, 0x0f, 0x5e, 0x83, 0x77, 0x25, 0x47, 0x2f, 0x25, 0x6d, 0x86, 0x36, 0x34, 0xdd,
+	0x09, 0xdb, 0x0d, 0x24, 0x6f, 0xe1, 0xd9, 0x27, 0xea, 0xb1, 0x47, 0x4f, 0x22, 0xc9, 0x8b, 0xc8,
+	0x6e, 0xaa, 0x04, 0xc4, 0xdb, 0xcc, 0x6f, 0x7e, 0x33, 0xbb, 0x33, 0x94, 0x27, 0x50, 0x06, 0x8b,
+	0xa2, 0x9a, 0xa3, 0x4a, 0x40, 0xcd, 0x17, 0x88, 0x1b, 0x3f, 0x57, 0xa8, 0x91, 0xb1, 0x54, 0x6a,
+	0x50, 0xcb, 0x75, 0x2c, 0x57, 0x20, 0x21, 0xf3, 0x13, 0x28, 0xa7, 0x93, 0x15, 0xae, 0xd0, 0x96,
+	0x03, 0x13, 0xb5, 0xe6, 0xf4, 0xd4, 0xcc, 0xb0, 0xfd, 0x47, 0x30, 0x31, 0x20, 0x8f, 0x53, 0x35,
+	0x57, 0x45, 0x06, 0xbb, 0x96, 0x5e, 0xbd, 0xf5, 0xe8, 0x49, 0x58, 0x54, 0x4f, 0x46, 0x0c, 0x11,
+	0x37, 0x6c, 0x42, 0xfb, 0xa9, 0x4c, 0xa0, 0xe4, 0xc4, 0x23, 0xb3, 0xff, 0x51, 0x9b, 0x30, 0x8f,
+	0x8e, 0xe3, 0x2d, 0x16, 0x52, 0x3f, 0x82, 0xc4, 0x2d, 0xef, 0xd9, 0x5a, 0x17, 0x31, 0x41, 0x69,
+	0xae, 0xd2, 0x25, 0xb4, 0xc2, 0x3f, 0x2b, 0x74, 0x08, 0xbb, 0xa1, 0xae, 0xd9, 0x83, 0xbb, 0x1e,
+	0x99, 0x8d, 0x6f, 0x2f, 0xfc, 0xdf, 0x8b, 0xf8, 0x3f, 0x9f, 0x88, 0xac, 0xca, 0x18, 0x75, 0x73,
+	0x54, 0x9a, 0xf7, 0xed, 0x30, 0x1b, 0x33, 0x4e, 0x87, 0xa6, 0x49, 0x42, 0xc6, 0x07, 0x16, 0x7f,
+	0xa7, 0xec, 0x81, 0xf6, 0xed, 0x62, 0x7c, 0xf8, 0xf7, 0x0b, 0xcf, 0x71, 0xaa, 0x22, 0x23, 0x85,
+	0xee, 0xfe, 0xe3, 0xd2, 0x89, 0xda, 0x0e, 0x76, 0x46, 0x07, 0xeb, 0x38, 0xd3, 0x90, 0xf0, 0x91,
+	0x47, 0x66, 0xa3, 0xe8, 0x98, 0x85, 0xf7, 0xfb, 0x5a, 0x90, 0x43, 0x2d, 0xc8, 0x67, 0x2d, 0xc8,
+	0x6b, 0x23, 0x9c, 0x43, 0x23, 0x9c, 0xf7, 0x46, 0x38, 0x2f, 0xe7, 0x9d, 0xe1, 0xd7, 0x12, 0xb2,
+	0xa0, 0x0c, 0xcc, 0x79, 0x75, 0x95, 0xc3, 0x6e, 0x31, 0xb0, 0xa7, 0xbd, 0xfb, 0x1a, 0x00, 0xf6,
+	0x63, 0x3f, 0xda, 0xc7, 0x01, 0x00, 0x00,
 }
 
 func (m *BuyOrderBook) Marshal() (dAtA []byte, err error) {
@@ -134,6 +175,40 @@ func (m *BuyOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyOrderBook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintBuyOrderBook(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintBuyOrderBook(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Book != nil {
 		{
 			size, err := m.Book.MarshalToSizedBuffer(dAtA[:i])
@@ -203,6 +278,19 @@ func (m *BuyOrderBook) Size() (n int) {
 		l = m.Book.Size()
 		n += 1 + l + sovBuyOrderBook(uint64(l))
 	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovBuyOrderBook(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovBuyOrderBook(uint64(l))
+	}
+	l = m.Rules.Size()
+	n += 1 + l + sovBuyOrderBook(uint64(l))
+	if m.Halted {
+		n += 2
+	}
 	return n
 }
 
@@ -373,6 +461,123 @@ func (m *BuyOrderBook) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyOrderBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBuyOrderBook(dAtA[iNdEx:])
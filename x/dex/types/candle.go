@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CandleIntervals are the intervals of the candles maintained for each pair
+var CandleIntervals = []CandleInterval{OneMinute, OneHour, OneDay}
+
+// candleIntervalNames are the short names of the candle intervals
+var candleIntervalNames = map[CandleInterval]string{
+	OneMinute: "1m",
+	OneHour:   "1h",
+	OneDay:    "1d",
+}
+
+// ParseCandleInterval returns the candle interval of a short name such as 1m, 1h or 1d
+func ParseCandleInterval(name string) (CandleInterval, error) {
+	for interval, intervalName := range candleIntervalNames {
+		if intervalName == name {
+			return interval, nil
+		}
+	}
+
+	return OneMinute, fmt.Errorf("invalid candle interval %s, must be one of 1m, 1h or 1d", name)
+}
+
+// Duration returns the time span of the interval
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case OneHour:
+		return time.Hour
+	case OneDay:
+		return 24 * time.Hour
+	default:
+		return time.Minute
+	}
+}
+
+// OpenTime returns the start in unix nanoseconds of the interval containing a time
+func (i CandleInterval) OpenTime(t time.Time) uint64 {
+	return uint64(t.Truncate(i.Duration()).UnixNano())
+}
+
+// NewCandle returns the candle of a pair opened by a trade
+func NewCandle(pairIndex string, interval CandleInterval, openTime uint64, price sdk.Dec) Candle {
+	return Candle{
+		PairIndex: pairIndex,
+		Interval:  interval,
+		OpenTime:  openTime,
+		Open:      price,
+		High:      price,
+		Low:       price,
+		Close:     price,
+		Volume:    sdk.ZeroInt(),
+	}
+}
+
+// AddTrade updates the candle with a trade executed at a price for an amount
+func (c *Candle) AddTrade(price sdk.Dec, amount sdk.Int) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume = c.Volume.Add(amount)
+}
+
+// CloseTime returns the end in unix nanoseconds of the interval of the candle
+func (c Candle) CloseTime() uint64 {
+	return c.OpenTime + uint64(c.Interval.Duration().Nanoseconds())
+}
@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/candle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CandleInterval is the time span aggregated by a candle
+type CandleInterval int32
+
+const (
+	OneMinute CandleInterval = 0
+	OneHour   CandleInterval = 1
+	OneDay    CandleInterval = 2
+)
+
+var CandleInterval_name = map[int32]string{
+	0: "CANDLE_INTERVAL_1M",
+	1: "CANDLE_INTERVAL_1H",
+	2: "CANDLE_INTERVAL_1D",
+}
+
+var CandleInterval_value = map[string]int32{
+	"CANDLE_INTERVAL_1M": 0,
+	"CANDLE_INTERVAL_1H": 1,
+	"CANDLE_INTERVAL_1D": 2,
+}
+
+func (x CandleInterval) String() string {
+	return proto.EnumName(CandleInterval_name, int32(x))
+}
+
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bf37d12114793e49, []int{0}
+}
+
+// Candle aggregates the trades of a pair executed on this chain during an interval
+type Candle struct {
+	PairIndex string         `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Interval  CandleInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=interchangenel.dex.CandleInterval" json:"interval,omitempty"`
+	// openTime is the start of the interval in unix nanoseconds
+	OpenTime uint64                                 `protobuf:"varint,3,opt,name=openTime,proto3" json:"openTime,omitempty"`
+	Open     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open"`
+	High     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high"`
+	Low      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low"`
+	Close    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close"`
+	// volume is the amount traded during the interval, in the amount denom of the pair
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf37d12114793e49, []int{0}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *Candle) GetInterval() CandleInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return OneMinute
+}
+
+func (m *Candle) GetOpenTime() uint64 {
+	if m != nil {
+		return m.OpenTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("interchangenel.dex.CandleInterval", CandleInterval_name, CandleInterval_value)
+	proto.RegisterType((*Candle)(nil), "interchangenel.dex.Candle")
+}
+
+func init() { proto.RegisterFile("dex/candle.proto", fileDescriptor_bf37d12114793e49) }
+
+var fileDescriptor_bf37d12114793e49 = []byte{
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0x87, 0x33, 0xdb, 0x6c, 0xb6, 0x1d, 0x71, 0x09, 0x83, 0x87, 0x21, 0x4a, 0x36, 0x54, 0x94,
+	0x22, 0x6c, 0x82, 0x8a, 0x57, 0x71, 0xbb, 0x59, 0xd9, 0xc0, 0x6e, 0x0b, 0xa1, 0x78, 0xf0, 0x52,
+	0xd2, 0xe4, 0x25, 0x09, 0x26, 0x33, 0x21, 0x7f, 0x6a, 0xfa, 0x05, 0x44, 0x7a, 0xf2, 0x0b, 0xf4,
+	0xe4, 0x97, 0xe9, 0xb1, 0x47, 0xe9, 0xa1, 0x48, 0xfb, 0x45, 0x24, 0x69, 0xa9, 0x8a, 0xbd, 0xd8,
+	0xd3, 0xbc, 0x33, 0xf3, 0x7b, 0x9e, 0x97, 0x61, 0x5e, 0x2c, 0x7b, 0x50, 0x1a, 0xae, 0xc3, 0xbc,
+	0x08, 0xf4, 0x24, 0xe5, 0x39, 0x27, 0x24, 0x64, 0x39, 0xa4, 0x6e, 0xe0, 0x30, 0x1f, 0x18, 0x44,
+	0xba, 0x07, 0xa5, 0xf2, 0xc8, 0xe7, 0x3e, 0xaf, 0xaf, 0x8d, 0xaa, 0xda, 0x26, 0xdb, 0xcb, 0x06,
+	0x96, 0xae, 0x6b, 0x94, 0x3c, 0xc1, 0xad, 0xc4, 0x09, 0x53, 0x8b, 0x79, 0x50, 0x52, 0xa4, 0xa1,
+	0x4e, 0xcb, 0xfe, 0x7d, 0x40, 0xde, 0xe2, 0x66, 0x2d, 0x1d, 0x3b, 0x11, 0x3d, 0xd1, 0x50, 0xe7,
+	0xfc, 0x55, 0x5b, 0xff, 0xb7, 0x8b, 0xbe, 0x75, 0x59, 0xbb, 0xa4, 0xbd, 0x67, 0x88, 0x82, 0x9b,
+	0x3c, 0x01, 0x36, 0x08, 0x63, 0xa0, 0x0d, 0x0d, 0x75, 0x44, 0x7b, 0xbf, 0x27, 0x5d, 0x2c, 0x56,
+	0x35, 0x15, 0xab, 0xa6, 0x5d, 0x7d, 0xbe, 0xba, 0x10, 0x96, 0xab, 0x8b, 0xe7, 0x7e, 0x98, 0x07,
+	0xc5, 0x48, 0x77, 0x79, 0x6c, 0xb8, 0x3c, 0x8b, 0x79, 0xb6, 0x5b, 0x2e, 0x33, 0xef, 0x93, 0x91,
+	0x4f, 0x12, 0xc8, 0x74, 0x13, 0x5c, 0xbb, 0x66, 0x2b, 0x47, 0x10, 0xfa, 0x01, 0x3d, 0x3d, 0xce,
+	0x51, 0xb1, 0xe4, 0x1d, 0x6e, 0x44, 0xfc, 0x33, 0x95, 0x8e, 0x52, 0x54, 0x28, 0x31, 0xf1, 0xa9,
+	0x1b, 0xf1, 0x0c, 0xe8, 0xd9, 0x51, 0x8e, 0x2d, 0x4c, 0xde, 0x63, 0x69, 0xcc, 0xa3, 0x22, 0x06,
+	0xda, 0xfc, 0x6f, 0x8d, 0xc5, 0x72, 0x7b, 0x47, 0xbf, 0xf8, 0x82, 0xf0, 0xf9, 0xdf, 0x1f, 0x42,
+	0x9e, 0x61, 0x72, 0x7d, 0xd5, 0x33, 0xef, 0x6e, 0x86, 0x56, 0x6f, 0x70, 0x63, 0x7f, 0xb8, 0xba,
+	0x1b, 0xbe, 0xbc, 0x97, 0x05, 0xe5, 0xe1, 0x74, 0xa6, 0xb5, 0xfa, 0x0c, 0xee, 0x43, 0x56, 0xe4,
+	0x40, 0x9e, 0x1e, 0x88, 0xdd, 0xca, 0x48, 0x79, 0x30, 0x9d, 0x69, 0x67, 0x7d, 0x06, 0xb7, 0xbc,
+	0x48, 0x49, 0xfb, 0x40, 0xc8, 0x94, 0x4f, 0x14, 0x3c, 0x9d, 0x69, 0x52, 0x9f, 0x81, 0xe9, 0x4c,
+	0x14, 0xf1, 0xeb, 0x77, 0x55, 0xe8, 0xbe, 0x99, 0xaf, 0x55, 0xb4, 0x58, 0xab, 0xe8, 0xe7, 0x5a,
+	0x45, 0xdf, 0x36, 0xaa, 0xb0, 0xd8, 0xa8, 0xc2, 0x8f, 0x8d, 0x2a, 0x7c, 0x7c, 0xfc, 0xc7, 0x0c,
+	0x5d, 0x32, 0x88, 0x8c, 0xd2, 0xa8, 0xa6, 0xb9, 0x7e, 0xcb, 0x48, 0xaa, 0x67, 0xf4, 0xf5, 0xaf,
+	0x01, 0x00, 0x50, 0x5f, 0x04, 0x97, 0xe1, 0x02, 0x00, 0x00,
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OpenTime != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.OpenTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintCandle(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCandle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCandle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovCandle(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCandle(uint64(m.Interval))
+	}
+	if m.OpenTime != 0 {
+		n += 1 + sovCandle(uint64(m.OpenTime))
+	}
+	l = m.Open.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	return n
+}
+
+func sovCandle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCandle(x uint64) (n int) {
+	return sovCandle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= CandleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenTime", wireType)
+			}
+			m.OpenTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCandle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCandle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCandle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCandle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCandle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCandle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCandle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestParseCandleInterval(t *testing.T) {
+	for name, expected := range map[string]types.CandleInterval{
+		"1m": types.OneMinute,
+		"1h": types.OneHour,
+		"1d": types.OneDay,
+	} {
+		interval, err := types.ParseCandleInterval(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, interval)
+	}
+
+	_, err := types.ParseCandleInterval("5m")
+	require.Error(t, err)
+}
+
+func TestCandleOpenTime(t *testing.T) {
+	blockTime := time.Date(2022, 5, 10, 13, 45, 30, 0, time.UTC)
+
+	require.Equal(t, uint64(time.Date(2022, 5, 10, 13, 45, 0, 0, time.UTC).UnixNano()), types.OneMinute.OpenTime(blockTime))
+	require.Equal(t, uint64(time.Date(2022, 5, 10, 13, 0, 0, 0, time.UTC).UnixNano()), types.OneHour.OpenTime(blockTime))
+	require.Equal(t, uint64(time.Date(2022, 5, 10, 0, 0, 0, 0, time.UTC).UnixNano()), types.OneDay.OpenTime(blockTime))
+}
+
+func TestCandleAddTrade(t *testing.T) {
+	candle := types.NewCandle("pair", types.OneHour, 0, sdk.NewDec(20))
+	candle.AddTrade(sdk.NewDec(20), sdk.NewInt(10))
+	candle.AddTrade(sdk.NewDec(25), sdk.NewInt(5))
+	candle.AddTrade(sdk.NewDec(15), sdk.NewInt(30))
+	candle.AddTrade(sdk.NewDec(18), sdk.NewInt(1))
+
+	require.Equal(t, types.Candle{
+		PairIndex: "pair",
+		Interval:  types.OneHour,
+		Open:      sdk.NewDec(20),
+		High:      sdk.NewDec(25),
+		Low:       sdk.NewDec(15),
+		Close:     sdk.NewDec(18),
+		Volume:    sdk.NewInt(46),
+	}, candle)
+	require.Equal(t, uint64(time.Hour.Nanoseconds()), candle.CloseTime())
+}
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -13,6 +14,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSendBuyOrder{}, "dex/SendBuyOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelSellOrder{}, "dex/CancelSellOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelBuyOrder{}, "dex/CancelBuyOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelStopOrder{}, "dex/CancelStopOrder", nil)
+	cdc.RegisterConcrete(&MsgAmendOrder{}, "dex/AmendOrder", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -32,8 +35,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCancelBuyOrder{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelStopOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAmendOrder{},
+	)
 	// this line is used by starport scaffolding # 3
 
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdatePairRulesProposal{},
+		&HaltPairProposal{},
+		&ResumePairProposal{},
+		&DelistPairProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DepthBucketPrice returns the price of the bucket of a price level, rounded away from the spread:
+// bids are rounded down and asks up to a multiple of the bucket size
+func DepthBucketPrice(price sdk.Dec, bucketSize sdk.Dec, side OrderSide) sdk.Dec {
+	if bucketSize.IsNil() || !bucketSize.IsPositive() {
+		return price
+	}
+
+	// decimals share the same precision, so their integer representations can be divided
+	priceInt := sdk.NewIntFromBigInt(price.BigInt())
+	bucketInt := sdk.NewIntFromBigInt(bucketSize.BigInt())
+	rest := priceInt.Mod(bucketInt)
+	bucketPrice := priceInt.Sub(rest)
+	if side == SellSide && !rest.IsZero() {
+		bucketPrice = bucketPrice.Add(bucketInt)
+	}
+
+	return sdk.NewDecFromBigIntWithPrec(bucketPrice.BigInt(), sdk.Precision)
+}
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestDepthBucketPrice(t *testing.T) {
+	bucketSize := sdk.NewDecWithPrec(5, 1)
+
+	// bids are rounded down and asks up
+	require.Equal(t, sdk.NewDec(12), types.DepthBucketPrice(sdk.NewDecWithPrec(1234, 2), bucketSize, types.BuySide))
+	require.Equal(t, sdk.NewDecWithPrec(125, 1), types.DepthBucketPrice(sdk.NewDecWithPrec(1234, 2), bucketSize, types.SellSide))
+
+	// prices on a bucket boundary are unchanged
+	require.Equal(t, sdk.NewDecWithPrec(125, 1), types.DepthBucketPrice(sdk.NewDecWithPrec(125, 1), bucketSize, types.SellSide))
+
+	// no bucket size keeps the price
+	require.Equal(t, sdk.NewDecWithPrec(1234, 2), types.DepthBucketPrice(sdk.NewDecWithPrec(1234, 2), sdk.ZeroDec(), types.BuySide))
+}
@@ -0,0 +1,14 @@
+package types
+
+// Trading events
+const (
+	EventTypeTradingFee = "trading_fee"
+
+	AttributeKeyPairIndex = "pair_index"
+	AttributeKeyPayer     = "payer"
+	AttributeKeyLiquidity = "liquidity"
+	AttributeKeyFee       = "fee"
+
+	AttributeValueMaker = "maker"
+	AttributeValueTaker = "taker"
+)
@@ -2,13 +2,15 @@ package types
 
 // IBC events
 const (
-	EventTypeTimeout          = "timeout"
-	EventTypeCreatePairPacket = "createPair_packet"
-	EventTypeSellOrderPacket  = "sellOrder_packet"
-	EventTypeBuyOrderPacket   = "buyOrder_packet"
+	EventTypeTimeout            = "timeout"
+	EventTypeCreatePairPacket   = "createPair_packet"
+	EventTypeSellOrderPacket    = "sellOrder_packet"
+	EventTypeBuyOrderPacket     = "buyOrder_packet"
+	EventTypeBatchAuctionQueued = "batchAuction_queued"
 	// this line is used by starport scaffolding # ibc/packet/event
 
-	AttributeKeyAckSuccess = "success"
-	AttributeKeyAck        = "acknowledgement"
-	AttributeKeyAckError   = "error"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAck            = "acknowledgement"
+	AttributeKeyAckError       = "error"
+	AttributeKeyPacketSequence = "packet_sequence"
 )
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FeeCollectorName is the name of the module account collecting the trading fees
+	FeeCollectorName = "dex_fee_collector"
+)
+
+var (
+	ErrInvalidFeeRate         = errors.New("fee rate must be at least zero and below one")
+	ErrInvalidFeeDistribution = errors.New("fee distribution shares must be at least zero and sum up to one")
+	ErrPairFeeOverride        = errors.New("the fee rates of a pair can only be overridden by governance")
+)
+
+// ValidateFeeRate checks that a fee rate leaves part of the proceeds to the trader
+func ValidateFeeRate(rate sdk.Dec) error {
+	if rate.IsNil() || rate.IsNegative() || rate.GTE(sdk.OneDec()) {
+		return ErrInvalidFeeRate
+	}
+
+	return nil
+}
+
+// FeeAmount returns the fee charged at a rate on proceeds, rounded down
+func FeeAmount(proceeds sdk.Int, rate sdk.Dec) sdk.Int {
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	return rate.MulInt(proceeds).TruncateInt()
+}
+
+// FeeRates returns the maker and taker fee rates of the pair, the ones of the params unless the rules
+// override them
+func (r PairRules) FeeRates(params Params) (maker sdk.Dec, taker sdk.Dec) {
+	maker, taker = params.MakerFeeRate, params.TakerFeeRate
+	if r.MakerFeeRate != nil {
+		maker = *r.MakerFeeRate
+	}
+	if r.TakerFeeRate != nil {
+		taker = *r.TakerFeeRate
+	}
+
+	return maker, taker
+}
+
+// HasFeeOverrides returns whether the rules override the fee rates of the params
+func (r PairRules) HasFeeOverrides() bool {
+	return r.MakerFeeRate != nil || r.TakerFeeRate != nil
+}
+
+// WithoutFeeOverrides returns the rules with the fee rates of the params
+func (r PairRules) WithoutFeeOverrides() PairRules {
+	r.MakerFeeRate, r.TakerFeeRate = nil, nil
+
+	return r
+}
+
+// NetProceeds returns the gain paid to the seller once the taker fee is deducted, and the fee. The fee of
+// acknowledgments without one is zero
+func (ack SellOrderPacketAck) NetProceeds() (net sdk.Int, fee sdk.Int) {
+	return netProceeds(ack.Gain, ack.TakerFee)
+}
+
+// NetProceeds returns the purchase paid to the buyer once the taker fee is deducted, and the fee. The fee
+// of acknowledgments without one is zero
+func (ack BuyOrderPacketAck) NetProceeds() (net sdk.Int, fee sdk.Int) {
+	return netProceeds(ack.Purchase, ack.TakerFee)
+}
+
+func netProceeds(proceeds sdk.Int, fee sdk.Int) (sdk.Int, sdk.Int) {
+	if fee.IsNil() || fee.IsNegative() {
+		return proceeds, sdk.ZeroInt()
+	}
+	fee = sdk.MinInt(fee, proceeds)
+
+	return proceeds.Sub(fee), fee
+}
+
+// NewFeeDistribution returns the shares of the collected fees sent to the stakers, to the community pool
+// and burned
+func NewFeeDistribution(stakers sdk.Dec, communityPool sdk.Dec, burn sdk.Dec) FeeDistribution {
+	return FeeDistribution{
+		Stakers:       stakers,
+		CommunityPool: communityPool,
+		Burn:          burn,
+	}
+}
+
+// Validate checks that the shares are not negative and sum up to one
+func (d FeeDistribution) Validate() error {
+	total := sdk.ZeroDec()
+	for _, share := range []sdk.Dec{d.Stakers, d.CommunityPool, d.Burn} {
+		if share.IsNil() || share.IsNegative() {
+			return ErrInvalidFeeDistribution
+		}
+		total = total.Add(share)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return ErrInvalidFeeDistribution
+	}
+
+	return nil
+}
+
+// Split splits an amount of collected fees into the parts of the stakers, of the community pool and
+// burned. The community pool and burned parts are rounded down, the stakers receive the rest
+func (d FeeDistribution) Split(amount sdk.Int) (stakers sdk.Int, communityPool sdk.Int, burn sdk.Int) {
+	communityPool = d.CommunityPool.MulInt(amount).TruncateInt()
+	burn = d.Burn.MulInt(amount).TruncateInt()
+
+	return amount.Sub(communityPool).Sub(burn), communityPool, burn
+}
+
+// NewFeeStats returns the fee stats of a denom in which no fee was collected
+func NewFeeStats(denom string) FeeStats {
+	return FeeStats{
+		Denom:         denom,
+		Collected:     sdk.ZeroInt(),
+		Stakers:       sdk.ZeroInt(),
+		CommunityPool: sdk.ZeroInt(),
+		Burned:        sdk.ZeroInt(),
+	}
+}
@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/fee_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeStats are the trading fees collected in a denom and how they were distributed
+type FeeStats struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// collected is the total of the fees collected
+	Collected github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=collected,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"collected"`
+	// stakers is the total sent to the fee collector of the distribution module
+	Stakers github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=stakers,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stakers"`
+	// communityPool is the total sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"communityPool"`
+	// burned is the total burned
+	Burned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=burned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned"`
+}
+
+func (m *FeeStats) Reset()         { *m = FeeStats{} }
+func (m *FeeStats) String() string { return proto.CompactTextString(m) }
+func (*FeeStats) ProtoMessage()    {}
+func (*FeeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb4410ded1907870, []int{0}
+}
+func (m *FeeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeStats.Merge(m, src)
+}
+func (m *FeeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeStats proto.InternalMessageInfo
+
+func (m *FeeStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FeeStats)(nil), "interchangenel.dex.FeeStats")
+}
+
+func init() { proto.RegisterFile("dex/fee_stats.proto", fileDescriptor_fb4410ded1907870) }
+
+var fileDescriptor_fb4410ded1907870 = []byte{
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x93, 0x6a, 0xab, 0x3d, 0x70, 0x39, 0x3b, 0x04, 0x85, 0xab, 0x38, 0x88, 0x4b, 0x73,
+	0x83, 0xf8, 0x05, 0x3a, 0x14, 0x05, 0x07, 0xa9, 0x4e, 0x2e, 0x92, 0xdc, 0x3d, 0xd3, 0xd0, 0xe4,
+	0x5e, 0xc9, 0xbd, 0x40, 0xfa, 0x2d, 0xfc, 0x58, 0x1d, 0x3b, 0x89, 0x38, 0x14, 0x49, 0xbe, 0x88,
+	0x24, 0xad, 0x68, 0xd7, 0x4c, 0xef, 0x3d, 0xfe, 0xef, 0xff, 0x5b, 0x7e, 0xec, 0x54, 0x43, 0x21,
+	0xdf, 0x00, 0x5e, 0x2d, 0x05, 0x64, 0xfd, 0x45, 0x86, 0x84, 0x9c, 0xc7, 0x86, 0x20, 0x53, 0xb3,
+	0xc0, 0x44, 0x60, 0x20, 0xf1, 0x35, 0x14, 0x67, 0x83, 0x08, 0x23, 0x6c, 0x62, 0x59, 0x6f, 0xdb,
+	0xcf, 0xcb, 0x8f, 0x0e, 0x3b, 0x9e, 0x00, 0x3c, 0xd5, 0x65, 0x3e, 0x60, 0x5d, 0x0d, 0x06, 0x53,
+	0xcf, 0xbd, 0x70, 0xaf, 0xfb, 0xd3, 0xed, 0xc1, 0x1f, 0x58, 0x5f, 0x61, 0x92, 0x80, 0x22, 0xd0,
+	0x5e, 0xa7, 0x4e, 0xc6, 0xfe, 0x6a, 0x33, 0x74, 0xbe, 0x36, 0xc3, 0xab, 0x28, 0xa6, 0x59, 0x1e,
+	0xfa, 0x0a, 0x53, 0xa9, 0xd0, 0xa6, 0x68, 0x77, 0x63, 0x64, 0xf5, 0x5c, 0xd2, 0x72, 0x01, 0xd6,
+	0xbf, 0x37, 0x34, 0xfd, 0x03, 0xf0, 0x3b, 0x76, 0x64, 0x29, 0x98, 0x43, 0x66, 0xbd, 0x83, 0x56,
+	0xac, 0xdf, 0x3a, 0x7f, 0x66, 0x27, 0x0a, 0xd3, 0x34, 0x37, 0x31, 0x2d, 0x1f, 0x11, 0x13, 0xef,
+	0xb0, 0x15, 0x6f, 0x1f, 0xc2, 0x27, 0xac, 0x17, 0xe6, 0x99, 0x01, 0xed, 0x75, 0x5b, 0xe1, 0x76,
+	0xed, 0xf1, 0xed, 0xaa, 0x14, 0xee, 0xba, 0x14, 0xee, 0x77, 0x29, 0xdc, 0xf7, 0x4a, 0x38, 0xeb,
+	0x4a, 0x38, 0x9f, 0x95, 0x70, 0x5e, 0xce, 0xff, 0xc9, 0x19, 0x19, 0x48, 0x64, 0x21, 0x6b, 0x87,
+	0x0d, 0x22, 0xec, 0x35, 0x5a, 0x6e, 0x7e, 0x06, 0x00, 0x16, 0xed, 0xd3, 0x3c, 0xd7, 0x01, 0x00,
+	0x00,
+}
+
+func (m *FeeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Stakers.Size()
+		i -= size
+		if _, err := m.Stakers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Collected.Size()
+		i -= size
+		if _, err := m.Collected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeStats(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeStats(uint64(l))
+	}
+	l = m.Collected.Size()
+	n += 1 + l + sovFeeStats(uint64(l))
+	l = m.Stakers.Size()
+	n += 1 + l + sovFeeStats(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovFeeStats(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovFeeStats(uint64(l))
+	return n
+}
+
+func sovFeeStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeStats(x uint64) (n int) {
+	return sovFeeStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stakers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestValidateFeeRate(t *testing.T) {
+	require.NoError(t, types.ValidateFeeRate(sdk.ZeroDec()))
+	require.NoError(t, types.ValidateFeeRate(sdk.NewDecWithPrec(3, 3)))
+	require.ErrorIs(t, types.ValidateFeeRate(sdk.NewDecWithPrec(-1, 3)), types.ErrInvalidFeeRate)
+	require.ErrorIs(t, types.ValidateFeeRate(sdk.OneDec()), types.ErrInvalidFeeRate)
+	require.ErrorIs(t, types.ValidateFeeRate(sdk.Dec{}), types.ErrInvalidFeeRate)
+
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+	params.TakerFeeRate = sdk.NewDecWithPrec(15, 1)
+	require.Error(t, params.Validate())
+}
+
+func TestFeeAmount(t *testing.T) {
+	// fees are rounded down
+	require.True(t, sdk.NewInt(2).Equal(types.FeeAmount(sdk.NewInt(999), sdk.NewDecWithPrec(3, 3))))
+	require.True(t, types.FeeAmount(sdk.NewInt(333), sdk.NewDecWithPrec(3, 3)).IsZero())
+	require.True(t, types.FeeAmount(sdk.NewInt(1000), sdk.ZeroDec()).IsZero())
+	require.True(t, types.FeeAmount(sdk.NewInt(1000), sdk.Dec{}).IsZero())
+}
+
+func TestPairRulesFeeRates(t *testing.T) {
+	params := types.DefaultParams()
+	params.MakerFeeRate = sdk.NewDecWithPrec(1, 3)
+	params.TakerFeeRate = sdk.NewDecWithPrec(2, 3)
+
+	maker, taker := testPairRules.FeeRates(params)
+	require.True(t, params.MakerFeeRate.Equal(maker))
+	require.True(t, params.TakerFeeRate.Equal(taker))
+
+	// a zero rate overrides the one of the params
+	rules := testPairRules
+	zero := sdk.ZeroDec()
+	rules.MakerFeeRate = &zero
+	maker, taker = rules.FeeRates(params)
+	require.True(t, maker.IsZero())
+	require.True(t, params.TakerFeeRate.Equal(taker))
+
+	invalid := sdk.OneDec()
+	rules.TakerFeeRate = &invalid
+	require.ErrorIs(t, rules.Validate(), types.ErrInvalidPairRules)
+}
+
+func TestPacketAckNetProceeds(t *testing.T) {
+	sellAck := types.SellOrderPacketAck{
+		Gain:     sdk.NewInt(1000),
+		TakerFee: sdk.NewInt(2),
+	}
+	net, fee := sellAck.NetProceeds()
+	require.True(t, sdk.NewInt(998).Equal(net))
+	require.True(t, sdk.NewInt(2).Equal(fee))
+
+	// the fee cannot exceed the proceeds
+	buyAck := types.BuyOrderPacketAck{
+		Purchase: sdk.NewInt(10),
+		TakerFee: sdk.NewInt(20),
+	}
+	net, fee = buyAck.NetProceeds()
+	require.True(t, net.IsZero())
+	require.True(t, sdk.NewInt(10).Equal(fee))
+
+	// acknowledgments without fee pay the whole proceeds
+	buyAck = types.BuyOrderPacketAck{
+		Purchase: sdk.NewInt(10),
+	}
+	net, fee = buyAck.NetProceeds()
+	require.True(t, sdk.NewInt(10).Equal(net))
+	require.True(t, fee.IsZero())
+}
+
+func TestFeeDistributionValidate(t *testing.T) {
+	require.NoError(t, types.DefaultFeeDistribution.Validate())
+	require.NoError(t, types.NewFeeDistribution(
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(2, 1),
+	).Validate())
+
+	// the shares must sum up to one
+	require.ErrorIs(t, types.NewFeeDistribution(
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(3, 1),
+		sdk.ZeroDec(),
+	).Validate(), types.ErrInvalidFeeDistribution)
+	require.ErrorIs(t, types.NewFeeDistribution(
+		sdk.NewDecWithPrec(15, 1),
+		sdk.ZeroDec(),
+		sdk.NewDecWithPrec(-5, 1),
+	).Validate(), types.ErrInvalidFeeDistribution)
+	require.ErrorIs(t, types.FeeDistribution{}.Validate(), types.ErrInvalidFeeDistribution)
+
+	params := types.DefaultParams()
+	params.FeeDistribution.Burn = sdk.NewDecWithPrec(1, 1)
+	require.Error(t, params.Validate())
+}
+
+func TestFeeDistributionSplit(t *testing.T) {
+	distribution := types.NewFeeDistribution(
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(2, 1),
+	)
+	stakers, communityPool, burn := distribution.Split(sdk.NewInt(1000))
+	require.True(t, sdk.NewInt(500).Equal(stakers))
+	require.True(t, sdk.NewInt(300).Equal(communityPool))
+	require.True(t, sdk.NewInt(200).Equal(burn))
+
+	// the stakers receive the rest of the parts rounded down
+	stakers, communityPool, burn = distribution.Split(sdk.NewInt(9))
+	require.True(t, sdk.NewInt(6).Equal(stakers))
+	require.True(t, sdk.NewInt(2).Equal(communityPool))
+	require.True(t, sdk.NewInt(1).Equal(burn))
+
+	stakers, communityPool, burn = types.DefaultFeeDistribution.Split(sdk.NewInt(9))
+	require.True(t, sdk.NewInt(9).Equal(stakers))
+	require.True(t, communityPool.IsZero())
+	require.True(t, burn.IsZero())
+}
@@ -15,6 +15,13 @@ func DefaultGenesis() *GenesisState {
 		SellOrderBookList: []SellOrderBook{},
 		BuyOrderBookList:  []BuyOrderBook{},
 		DenomTraceList:    []DenomTrace{},
+		StopOrderList:     []StopOrder{},
+		LastPriceList:     []LastPrice{},
+		OrderReserveList:  []OrderReserve{},
+		TradeList:         []Trade{},
+		CandleList:        []Candle{},
+		PendingOrderList:  []PendingOrder{},
+		FeeStatsList:      []FeeStats{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -34,6 +41,14 @@ func (gs GenesisState) Validate() error {
 		if _, ok := sellOrderBookIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for sellOrderBook")
 		}
+		if err := elem.Rules.Validate(); err != nil {
+			return fmt.Errorf("invalid rules for sellOrderBook: %w", err)
+		}
+		if elem.Book != nil {
+			if err := elem.Book.ValidateOrders(); err != nil {
+				return fmt.Errorf("invalid orders for sellOrderBook: %w", err)
+			}
+		}
 		sellOrderBookIndexMap[index] = struct{}{}
 	}
 	// Check for duplicated index in buyOrderBook
@@ -44,6 +59,14 @@ func (gs GenesisState) Validate() error {
 		if _, ok := buyOrderBookIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for buyOrderBook")
 		}
+		if err := elem.Rules.Validate(); err != nil {
+			return fmt.Errorf("invalid rules for buyOrderBook: %w", err)
+		}
+		if elem.Book != nil {
+			if err := elem.Book.ValidateOrders(); err != nil {
+				return fmt.Errorf("invalid orders for buyOrderBook: %w", err)
+			}
+		}
 		buyOrderBookIndexMap[index] = struct{}{}
 	}
 	// Check for duplicated index in denomTrace
@@ -56,6 +79,89 @@ func (gs GenesisState) Validate() error {
 		}
 		denomTraceIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated ID in stopOrder
+	stopOrderIdMap := make(map[uint64]bool)
+	stopOrderCount := gs.GetStopOrderCount()
+	for _, elem := range gs.StopOrderList {
+		if _, ok := stopOrderIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for stopOrder")
+		}
+		if elem.Id >= stopOrderCount {
+			return fmt.Errorf("stopOrder id should be lower or equal than the last id")
+		}
+		if elem.GetSellOrder() == nil && elem.GetBuyOrder() == nil {
+			return fmt.Errorf("stopOrder should have an order")
+		}
+		stopOrderIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in lastPrice
+	lastPriceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.LastPriceList {
+		index := string(LastPriceKey(elem.PairIndex))
+		if _, ok := lastPriceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for lastPrice")
+		}
+		lastPriceIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in orderReserve
+	orderReserveIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OrderReserveList {
+		index := string(OrderIDIndexKey(elem.PairIndex, OrderSide(elem.Side), elem.Id))
+		if _, ok := orderReserveIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for orderReserve")
+		}
+		if elem.Reserve.IsNil() || !elem.Reserve.IsPositive() {
+			return fmt.Errorf("orderReserve should have a positive reserve")
+		}
+		orderReserveIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in trade
+	tradeIdMap := make(map[uint64]bool)
+	tradeCount := gs.GetTradeCount()
+	for _, elem := range gs.TradeList {
+		if _, ok := tradeIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for trade")
+		}
+		if elem.Id >= tradeCount {
+			return fmt.Errorf("trade id should be lower or equal than the last id")
+		}
+		tradeIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in candle
+	candleIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.CandleList {
+		index := string(CandleKey(elem.PairIndex, elem.Interval, elem.OpenTime))
+		if _, ok := candleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for candle")
+		}
+		if _, ok := CandleInterval_name[int32(elem.Interval)]; !ok {
+			return fmt.Errorf("invalid interval for candle")
+		}
+		candleIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in pendingOrder
+	pendingOrderIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PendingOrderList {
+		index := string(PendingOrderKey(elem.Port, elem.Channel, elem.Sequence))
+		if _, ok := pendingOrderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pendingOrder")
+		}
+		pendingOrderIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in feeStats
+	feeStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FeeStatsList {
+		index := string(FeeStatsKey(elem.Denom))
+		if _, ok := feeStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for feeStats")
+		}
+		feeStatsIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -30,6 +30,15 @@ type GenesisState struct {
 	SellOrderBookList []SellOrderBook `protobuf:"bytes,3,rep,name=sellOrderBookList,proto3" json:"sellOrderBookList"`
 	BuyOrderBookList  []BuyOrderBook  `protobuf:"bytes,4,rep,name=buyOrderBookList,proto3" json:"buyOrderBookList"`
 	DenomTraceList    []DenomTrace    `protobuf:"bytes,5,rep,name=denomTraceList,proto3" json:"denomTraceList"`
+	StopOrderList     []StopOrder     `protobuf:"bytes,6,rep,name=stopOrderList,proto3" json:"stopOrderList"`
+	StopOrderCount    uint64          `protobuf:"varint,7,opt,name=stopOrderCount,proto3" json:"stopOrderCount,omitempty"`
+	LastPriceList     []LastPrice     `protobuf:"bytes,8,rep,name=lastPriceList,proto3" json:"lastPriceList"`
+	OrderReserveList  []OrderReserve  `protobuf:"bytes,9,rep,name=orderReserveList,proto3" json:"orderReserveList"`
+	TradeList         []Trade         `protobuf:"bytes,10,rep,name=tradeList,proto3" json:"tradeList"`
+	TradeCount        uint64          `protobuf:"varint,11,opt,name=tradeCount,proto3" json:"tradeCount,omitempty"`
+	CandleList        []Candle        `protobuf:"bytes,12,rep,name=candleList,proto3" json:"candleList"`
+	PendingOrderList  []PendingOrder  `protobuf:"bytes,13,rep,name=pendingOrderList,proto3" json:"pendingOrderList"`
+	FeeStatsList      []FeeStats      `protobuf:"bytes,14,rep,name=feeStatsList,proto3" json:"feeStatsList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +109,69 @@ func (m *GenesisState) GetDenomTraceList() []DenomTrace {
 	return nil
 }
 
+func (m *GenesisState) GetStopOrderList() []StopOrder {
+	if m != nil {
+		return m.StopOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStopOrderCount() uint64 {
+	if m != nil {
+		return m.StopOrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastPriceList() []LastPrice {
+	if m != nil {
+		return m.LastPriceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderReserveList() []OrderReserve {
+	if m != nil {
+		return m.OrderReserveList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradeList() []Trade {
+	if m != nil {
+		return m.TradeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradeCount() uint64 {
+	if m != nil {
+		return m.TradeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCandleList() []Candle {
+	if m != nil {
+		return m.CandleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOrderList() []PendingOrder {
+	if m != nil {
+		return m.PendingOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeStatsList() []FeeStats {
+	if m != nil {
+		return m.FeeStatsList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "interchangenel.dex.GenesisState")
 }
@@ -107,28 +179,42 @@ func init() {
 func init() { proto.RegisterFile("dex/genesis.proto", fileDescriptor_a803aaabd08db59d) }
 
 var fileDescriptor_a803aaabd08db59d = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x33, 0x6d, 0xff, 0xfe, 0x38, 0x15, 0x69, 0x07, 0xc5, 0x18, 0x61, 0x8c, 0xae, 0xba,
-	0x31, 0x81, 0x8a, 0xe0, 0x3a, 0x08, 0x22, 0x14, 0x94, 0x56, 0x37, 0x6e, 0x42, 0xd2, 0xb9, 0xd4,
-	0xd0, 0x34, 0x13, 0x26, 0x53, 0x68, 0xdf, 0xc2, 0xc7, 0xea, 0xb2, 0x4b, 0x71, 0x21, 0xd2, 0xbe,
-	0x88, 0xcc, 0x64, 0x90, 0x6a, 0xba, 0x4b, 0xee, 0x39, 0xe7, 0x9b, 0xc3, 0xbd, 0xb8, 0xc3, 0x60,
-	0xee, 0x8f, 0x21, 0x83, 0x22, 0x29, 0xbc, 0x5c, 0x70, 0xc9, 0x09, 0x49, 0x32, 0x09, 0x62, 0xf4,
-	0x1a, 0x65, 0x6a, 0x9e, 0x7a, 0x0c, 0xe6, 0xce, 0xe1, 0x98, 0x8f, 0xb9, 0x96, 0x7d, 0xf5, 0x55,
-	0x3a, 0x9d, 0xb6, 0x0a, 0xe7, 0x91, 0x88, 0xa6, 0x26, 0xeb, 0x9c, 0xa8, 0x49, 0x01, 0x69, 0x1a,
-	0x72, 0xc1, 0x40, 0x84, 0x31, 0xe7, 0x13, 0x23, 0xd9, 0x4a, 0x8a, 0x67, 0x8b, 0xaa, 0x72, 0xa4,
-	0x14, 0x06, 0x19, 0x9f, 0x86, 0x52, 0x44, 0x23, 0x28, 0xc7, 0x17, 0x1f, 0x35, 0xbc, 0x7f, 0x57,
-	0x36, 0x1b, 0xca, 0x48, 0x02, 0xb9, 0xc1, 0xcd, 0xf2, 0x31, 0x1b, 0xb9, 0xa8, 0xdb, 0xea, 0x39,
-	0x5e, 0xb5, 0xa9, 0xf7, 0xa8, 0x1d, 0x41, 0x63, 0xf9, 0x79, 0x66, 0x0d, 0x8c, 0x9f, 0x1c, 0xe3,
-	0xff, 0x39, 0x17, 0x32, 0x4c, 0x98, 0x5d, 0x73, 0x51, 0x77, 0x6f, 0xd0, 0x54, 0xbf, 0xf7, 0x8c,
-	0x3c, 0xe3, 0x8e, 0x6a, 0xfb, 0xa0, 0x2a, 0x05, 0x9c, 0x4f, 0xfa, 0x49, 0x21, 0xed, 0xba, 0x5b,
-	0xef, 0xb6, 0x7a, 0xe7, 0xbb, 0xe8, 0xc3, 0x6d, 0xb3, 0x79, 0xa4, 0x4a, 0x20, 0x03, 0xdc, 0x8e,
-	0x67, 0x8b, 0xdf, 0xd4, 0x86, 0xa6, 0xba, 0xbb, 0xa8, 0xc1, 0x96, 0xd7, 0x40, 0x2b, 0x79, 0xd2,
-	0xc7, 0x07, 0x7a, 0x47, 0x4f, 0x6a, 0x45, 0x9a, 0xf8, 0x4f, 0x13, 0xe9, 0x2e, 0xe2, 0xed, 0x8f,
-	0xd3, 0xf0, 0xfe, 0x64, 0x83, 0xeb, 0xe5, 0x9a, 0xa2, 0xd5, 0x9a, 0xa2, 0xaf, 0x35, 0x45, 0x6f,
-	0x1b, 0x6a, 0xad, 0x36, 0xd4, 0x7a, 0xdf, 0x50, 0xeb, 0xe5, 0x74, 0x0b, 0x77, 0x99, 0x41, 0xea,
-	0xab, 0xdb, 0xcc, 0x7d, 0xb9, 0xc8, 0xa1, 0x88, 0x9b, 0xfa, 0x34, 0x57, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x5f, 0x02, 0x39, 0x39, 0x37, 0x02, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x6e, 0xda, 0x4c,
+	0x10, 0xc7, 0xf1, 0x07, 0x1f, 0x94, 0x85, 0xd0, 0x64, 0x9b, 0x2a, 0x84, 0xb6, 0xae, 0xdb, 0x43,
+	0xc5, 0xa5, 0x20, 0xa5, 0xaa, 0xd4, 0x4b, 0xa5, 0x8a, 0x54, 0xa9, 0x22, 0x21, 0x05, 0x91, 0xf4,
+	0xd2, 0x8b, 0x65, 0xf0, 0x84, 0x5a, 0x71, 0xbc, 0xd6, 0x7a, 0xa9, 0xe0, 0x2d, 0xfa, 0x1a, 0x7d,
+	0x93, 0x1c, 0x73, 0xec, 0xa9, 0xaa, 0xe0, 0x45, 0xaa, 0x19, 0xaf, 0x89, 0x6d, 0xcc, 0x0d, 0xcf,
+	0xfc, 0xff, 0xbf, 0xd9, 0x99, 0x9d, 0x85, 0x1d, 0xb8, 0xb0, 0xe8, 0xcf, 0x20, 0x80, 0xc8, 0x8b,
+	0x7a, 0xa1, 0x14, 0x4a, 0x70, 0xee, 0x05, 0x0a, 0xe4, 0xf4, 0xbb, 0x13, 0x60, 0xdc, 0xef, 0xb9,
+	0xb0, 0xe8, 0x1c, 0xce, 0xc4, 0x4c, 0x50, 0xba, 0x8f, 0xbf, 0x62, 0x65, 0x67, 0x1f, 0xcd, 0xa1,
+	0x23, 0x9d, 0x5b, 0xed, 0xed, 0x1c, 0x63, 0x24, 0x02, 0xdf, 0xb7, 0x85, 0x74, 0x41, 0xda, 0x13,
+	0x21, 0x6e, 0x74, 0xaa, 0x8d, 0xa9, 0xc9, 0x7c, 0xb9, 0x9d, 0x79, 0x8a, 0x19, 0x17, 0x02, 0x71,
+	0x6b, 0x2b, 0xe9, 0x4c, 0x41, 0x87, 0x0f, 0x89, 0xa5, 0x44, 0x18, 0x3b, 0xd2, 0x51, 0xdf, 0x89,
+	0x94, 0x1d, 0x4a, 0x6f, 0xa3, 0x7d, 0x8c, 0xd1, 0xb4, 0x8c, 0x02, 0x4a, 0x3a, 0x2e, 0xa4, 0xcf,
+	0x3a, 0x75, 0x02, 0xd7, 0x4f, 0x22, 0x47, 0x74, 0x7a, 0x08, 0x5c, 0x2f, 0x98, 0x65, 0x4a, 0x3c,
+	0xc1, 0xc4, 0x35, 0x80, 0x1d, 0x29, 0x47, 0xe9, 0xce, 0x5e, 0xff, 0xaa, 0xb1, 0xe6, 0x97, 0x78,
+	0x4e, 0x97, 0xca, 0x51, 0xc0, 0x3f, 0xb0, 0x6a, 0xdc, 0x7a, 0xdb, 0xb0, 0x8c, 0x6e, 0xe3, 0xa4,
+	0xd3, 0xdb, 0x9e, 0x5b, 0x6f, 0x44, 0x8a, 0x41, 0xe5, 0xee, 0xcf, 0xcb, 0xd2, 0x58, 0xeb, 0xf9,
+	0x11, 0xab, 0x85, 0x42, 0x2a, 0xdb, 0x73, 0xdb, 0xff, 0x59, 0x46, 0xb7, 0x3e, 0xae, 0xe2, 0xe7,
+	0xb9, 0xcb, 0xbf, 0xb2, 0x03, 0x9c, 0xdd, 0x05, 0x9e, 0x65, 0x20, 0xc4, 0xcd, 0xd0, 0x8b, 0x54,
+	0xbb, 0x6c, 0x95, 0xbb, 0x8d, 0x93, 0x57, 0x45, 0xf4, 0xcb, 0xb4, 0x58, 0x17, 0xd9, 0x26, 0xf0,
+	0x31, 0xdb, 0x9f, 0xcc, 0x97, 0x59, 0x6a, 0x85, 0xa8, 0x56, 0x11, 0x75, 0x30, 0x5f, 0xe6, 0xa1,
+	0x5b, 0x7e, 0x3e, 0x64, 0x2d, 0xba, 0xb1, 0x2b, 0xbc, 0x30, 0x22, 0xfe, 0x4f, 0x44, 0xb3, 0x88,
+	0xf8, 0x79, 0xa3, 0xd4, 0xbc, 0x9c, 0x97, 0x9f, 0xb3, 0x3d, 0xbc, 0x68, 0x2a, 0x41, 0xb0, 0x2a,
+	0xc1, 0x5e, 0x14, 0x36, 0x9d, 0x08, 0x35, 0x2b, 0xeb, 0xe4, 0x6f, 0x58, 0x6b, 0x13, 0x38, 0x15,
+	0xf3, 0x40, 0xb5, 0x6b, 0x96, 0xd1, 0xad, 0x8c, 0x73, 0x51, 0x2c, 0x89, 0x5b, 0x34, 0xc2, 0x25,
+	0xa2, 0x92, 0x8f, 0x76, 0x97, 0x1c, 0x26, 0xc2, 0xa4, 0x64, 0xc6, 0x89, 0xf3, 0xa5, 0xf5, 0x19,
+	0x43, 0x04, 0xf2, 0x47, 0x4c, 0xab, 0xef, 0x9e, 0xef, 0x45, 0x4a, 0x9b, 0xcc, 0x37, 0xef, 0xe7,
+	0x1f, 0x59, 0x9d, 0xb6, 0x97, 0x60, 0x8c, 0x60, 0xc7, 0x45, 0xb0, 0x2b, 0x14, 0x69, 0xca, 0x83,
+	0x83, 0x9b, 0x8c, 0xd1, 0x47, 0x3c, 0x81, 0x06, 0x4d, 0x20, 0x15, 0xe1, 0x9f, 0x18, 0x8b, 0xdf,
+	0x02, 0xf1, 0x9b, 0x56, 0x79, 0xd7, 0x02, 0x9f, 0x92, 0x4a, 0x17, 0x48, 0x79, 0xb0, 0x69, 0xfd,
+	0x76, 0x1e, 0x6e, 0x6d, 0x6f, 0x77, 0xd3, 0xa3, 0x94, 0x36, 0x69, 0x3a, 0xef, 0xe7, 0x67, 0xac,
+	0x79, 0x0d, 0x80, 0xcf, 0x2b, 0x22, 0x5e, 0x8b, 0x78, 0xcf, 0x8b, 0x78, 0x67, 0x5a, 0xa7, 0x59,
+	0x19, 0xdf, 0xe0, 0xfd, 0xdd, 0xca, 0x34, 0xee, 0x57, 0xa6, 0xf1, 0x77, 0x65, 0x1a, 0x3f, 0xd7,
+	0x66, 0xe9, 0x7e, 0x6d, 0x96, 0x7e, 0xaf, 0xcd, 0xd2, 0xb7, 0x67, 0x29, 0xd4, 0xdb, 0x00, 0xfc,
+	0x3e, 0xfe, 0xf1, 0x2c, 0xfa, 0x6a, 0x19, 0x42, 0x34, 0xa9, 0xd2, 0x4b, 0x7f, 0xf7, 0x6f, 0x00,
+	0xf7, 0xf2, 0x97, 0x6e, 0x14, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +237,114 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeStatsList) > 0 {
+		for iNdEx := len(m.FeeStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PendingOrderList) > 0 {
+		for iNdEx := len(m.PendingOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CandleList) > 0 {
+		for iNdEx := len(m.CandleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.TradeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TradeList) > 0 {
+		for iNdEx := len(m.TradeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OrderReserveList) > 0 {
+		for iNdEx := len(m.OrderReserveList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderReserveList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LastPriceList) > 0 {
+		for iNdEx := len(m.LastPriceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastPriceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.StopOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StopOrderCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.StopOrderList) > 0 {
+		for iNdEx := len(m.StopOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StopOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DenomTraceList) > 0 {
 		for iNdEx := len(m.DenomTraceList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -254,6 +448,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StopOrderList) > 0 {
+		for _, e := range m.StopOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StopOrderCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StopOrderCount))
+	}
+	if len(m.LastPriceList) > 0 {
+		for _, e := range m.LastPriceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderReserveList) > 0 {
+		for _, e := range m.OrderReserveList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradeList) > 0 {
+		for _, e := range m.TradeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TradeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TradeCount))
+	}
+	if len(m.CandleList) > 0 {
+		for _, e := range m.CandleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOrderList) > 0 {
+		for _, e := range m.PendingOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeStatsList) > 0 {
+		for _, e := range m.FeeStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -459,6 +701,282 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopOrderList = append(m.StopOrderList, StopOrder{})
+			if err := m.StopOrderList[len(m.StopOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopOrderCount", wireType)
+			}
+			m.StopOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastPriceList = append(m.LastPriceList, LastPrice{})
+			if err := m.LastPriceList[len(m.LastPriceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderReserveList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderReserveList = append(m.OrderReserveList, OrderReserve{})
+			if err := m.OrderReserveList[len(m.OrderReserveList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeList = append(m.TradeList, Trade{})
+			if err := m.TradeList[len(m.TradeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleList = append(m.CandleList, Candle{})
+			if err := m.CandleList[len(m.CandleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOrderList = append(m.PendingOrderList, PendingOrder{})
+			if err := m.PendingOrderList[len(m.PendingOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeStatsList = append(m.FeeStatsList, FeeStats{})
+			if err := m.FeeStatsList[len(m.FeeStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
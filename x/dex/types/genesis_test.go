@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"interchange-nel/x/dex/types"
 )
@@ -21,10 +22,18 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				PortId: types.PortID,
 				SellOrderBookList: []types.SellOrderBook{
 					{
 						Index: "0",
+						Book: &types.OrderBook{
+							IdCount: 2,
+							Orders: []*types.Order{
+								{Id: 1, Amount: sdk.NewInt(10), Price: sdk.NewDec(2)},
+								{Id: 0, Amount: sdk.NewInt(20), Price: sdk.NewDec(3)},
+							},
+						},
 					},
 					{
 						Index: "1",
@@ -46,6 +55,76 @@ func TestGenesisState_Validate(t *testing.T) {
 						Index: "1",
 					},
 				},
+				StopOrderList: []types.StopOrder{
+					{
+						Id:     0,
+						Packet: &types.StopOrder_SellOrder{SellOrder: &types.SellOrderPacketData{}},
+					},
+					{
+						Id:     1,
+						Packet: &types.StopOrder_BuyOrder{BuyOrder: &types.BuyOrderPacketData{}},
+					},
+				},
+				StopOrderCount: 2,
+				LastPriceList: []types.LastPrice{
+					{
+						PairIndex: "0",
+					},
+					{
+						PairIndex: "1",
+					},
+				},
+				OrderReserveList: []types.OrderReserve{
+					{
+						PairIndex: "0",
+						Side:      uint32(types.SellSide),
+						Reserve:   sdk.NewInt(10),
+					},
+					{
+						PairIndex: "1",
+						Side:      uint32(types.SellSide),
+						Reserve:   sdk.NewInt(10),
+					},
+				},
+				TradeList: []types.Trade{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				TradeCount: 2,
+				CandleList: []types.Candle{
+					{
+						PairIndex: "0",
+						Interval:  types.OneMinute,
+					},
+					{
+						PairIndex: "0",
+						Interval:  types.OneHour,
+					},
+				},
+				PendingOrderList: []types.PendingOrder{
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 1,
+					},
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 2,
+					},
+				},
+				FeeStatsList: []types.FeeStats{
+					{
+						Denom: "foo",
+					},
+					{
+						Denom: "bar",
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -92,6 +171,290 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated stopOrder",
+			genState: &types.GenesisState{
+				StopOrderList: []types.StopOrder{
+					{
+						Id:     0,
+						Packet: &types.StopOrder_SellOrder{SellOrder: &types.SellOrderPacketData{}},
+					},
+					{
+						Id:     0,
+						Packet: &types.StopOrder_SellOrder{SellOrder: &types.SellOrderPacketData{}},
+					},
+				},
+				StopOrderCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid stopOrder count",
+			genState: &types.GenesisState{
+				StopOrderList: []types.StopOrder{
+					{
+						Id:     1,
+						Packet: &types.StopOrder_SellOrder{SellOrder: &types.SellOrderPacketData{}},
+					},
+				},
+				StopOrderCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "stopOrder without order",
+			genState: &types.GenesisState{
+				StopOrderList: []types.StopOrder{
+					{
+						Id: 0,
+					},
+				},
+				StopOrderCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated lastPrice",
+			genState: &types.GenesisState{
+				LastPriceList: []types.LastPrice{
+					{
+						PairIndex: "0",
+					},
+					{
+						PairIndex: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated orderReserve",
+			genState: &types.GenesisState{
+				OrderReserveList: []types.OrderReserve{
+					{
+						PairIndex: "0",
+						Side:      uint32(types.SellSide),
+						Reserve:   sdk.NewInt(10),
+					},
+					{
+						PairIndex: "0",
+						Side:      uint32(types.SellSide),
+						Reserve:   sdk.NewInt(10),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty orderReserve",
+			genState: &types.GenesisState{
+				OrderReserveList: []types.OrderReserve{
+					{
+						PairIndex: "0",
+						Side:      uint32(types.SellSide),
+						Reserve:   sdk.ZeroInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid buyOrderBook rules",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				BuyOrderBookList: []types.BuyOrderBook{
+					{
+						Index: "0",
+						Rules: types.PairRules{LotSize: sdk.NewInt(-1)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated order id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				SellOrderBookList: []types.SellOrderBook{
+					{
+						Index: "0",
+						Book: &types.OrderBook{
+							IdCount: 2,
+							Orders: []*types.Order{
+								{Id: 1, Amount: sdk.NewInt(10), Price: sdk.NewDec(2)},
+								{Id: 1, Amount: sdk.NewInt(20), Price: sdk.NewDec(3)},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "order id not allocated by the book",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				BuyOrderBookList: []types.BuyOrderBook{
+					{
+						Index: "0",
+						Book: &types.OrderBook{
+							IdCount: 1,
+							Orders: []*types.Order{
+								{Id: 1, Amount: sdk.NewInt(10), Price: sdk.NewDec(2)},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "order refilled with the time priority of another order",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				SellOrderBookList: []types.SellOrderBook{
+					{
+						Index: "0",
+						Book: &types.OrderBook{
+							IdCount: 2,
+							Orders: []*types.Order{
+								{Id: 0, Amount: sdk.NewInt(10), Price: sdk.NewDec(2), Sequence: 1},
+								{Id: 1, Amount: sdk.NewInt(10), Price: sdk.NewDec(2)},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "order without amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				SellOrderBookList: []types.SellOrderBook{
+					{
+						Index: "0",
+						Book: &types.OrderBook{
+							IdCount: 1,
+							Orders: []*types.Order{
+								{Id: 0, Amount: sdk.ZeroInt(), Price: sdk.NewDec(2)},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "order with negative price",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				BuyOrderBookList: []types.BuyOrderBook{
+					{
+						Index: "0",
+						Book: &types.OrderBook{
+							IdCount: 1,
+							Orders: []*types.Order{
+								{Id: 0, Amount: sdk.NewInt(10), Price: sdk.NewDec(-2)},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated trade",
+			genState: &types.GenesisState{
+				TradeList: []types.Trade{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				TradeCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid trade count",
+			genState: &types.GenesisState{
+				TradeList: []types.Trade{
+					{
+						Id: 1,
+					},
+				},
+				TradeCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated candle",
+			genState: &types.GenesisState{
+				CandleList: []types.Candle{
+					{
+						PairIndex: "0",
+						OpenTime:  60,
+					},
+					{
+						PairIndex: "0",
+						OpenTime:  60,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid candle interval",
+			genState: &types.GenesisState{
+				CandleList: []types.Candle{
+					{
+						PairIndex: "0",
+						Interval:  types.CandleInterval(3),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pendingOrder",
+			genState: &types.GenesisState{
+				PendingOrderList: []types.PendingOrder{
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 1,
+					},
+					{
+						Port:     "dex",
+						Channel:  "channel-0",
+						Sequence: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated feeStats",
+			genState: &types.GenesisState{
+				FeeStatsList: []types.FeeStats{
+					{
+						Denom: "foo",
+					},
+					{
+						Denom: "foo",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
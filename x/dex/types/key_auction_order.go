@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AuctionOrderKey is the prefix to retrieve all AuctionOrder
+	AuctionOrderKey = "AuctionOrder/value/"
+
+	// AuctionOrderCountKey is the key of the number of orders ever queued for batch auctions
+	AuctionOrderCountKey = "AuctionOrder/count/"
+)
+
+// AuctionOrderIDKey returns the store key to retrieve an AuctionOrder from its ID
+func AuctionOrderIDKey(
+	id uint64,
+) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CandleKeyPrefix is the prefix to retrieve all Candle
+	CandleKeyPrefix = "Candle/value/"
+)
+
+// CandleSeriesKey returns the store key prefix of the candles of a pair at an interval, ordered by
+// open time
+func CandleSeriesKey(
+	pairIndex string,
+	interval CandleInterval,
+) []byte {
+	key := lengthPrefix(pairIndex)
+	return append(key, byte(interval))
+}
+
+// CandleKey returns the store key to retrieve a Candle from the index fields
+func CandleKey(
+	pairIndex string,
+	interval CandleInterval,
+	openTime uint64,
+) []byte {
+	return append(CandleSeriesKey(pairIndex, interval), sdk.Uint64ToBigEndian(openTime)...)
+}
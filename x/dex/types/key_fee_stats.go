@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// FeeStatsKeyPrefix is the prefix to retrieve all FeeStats
+	FeeStatsKeyPrefix = "FeeStats/value/"
+)
+
+// FeeStatsKey returns the store key to retrieve a FeeStats from the index fields
+func FeeStatsKey(
+	denom string,
+) []byte {
+	var key []byte
+
+	denomBytes := []byte(denom)
+	key = append(key, denomBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
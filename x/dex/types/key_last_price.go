@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// LastPriceKeyPrefix is the prefix to retrieve all LastPrice
+	LastPriceKeyPrefix = "LastPrice/value/"
+)
+
+// LastPriceKey returns the store key to retrieve a LastPrice from the index fields
+func LastPriceKey(
+	pairIndex string,
+) []byte {
+	var key []byte
+
+	pairIndexBytes := []byte(pairIndex)
+	key = append(key, pairIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// OrderKeyPrefix is the prefix to retrieve all Order
+	OrderKeyPrefix = "Order/value/"
+
+	// OrderIDIndexKeyPrefix is the prefix of the index from pair and order ID to order location
+	OrderIDIndexKeyPrefix = "Order/id/"
+
+	// OrderCreatorIndexKeyPrefix is the prefix of the index from creator, pair and order ID to order
+	// location
+	OrderCreatorIndexKeyPrefix = "Order/creator/"
+
+	// OrderExpiryQueueKeyPrefix is the prefix of the queue of the orders with an expiry, sorted by
+	// expiry timestamp or height
+	OrderExpiryQueueKeyPrefix = "Order/expiry/"
+
+	// OrderReserveKeyPrefix is the prefix of the hidden reserves of iceberg orders, keyed by pair, side
+	// and order ID
+	OrderReserveKeyPrefix = "Order/reserve/"
+)
+
+var (
+	orderExpiryTimestampKey = []byte{0x01}
+	orderExpiryHeightKey    = []byte{0x02}
+)
+
+// OrderSide is the side of a pair an order rests on
+type OrderSide byte
+
+const (
+	BuySide OrderSide = iota + 1
+	SellSide
+)
+
+// OrderBookSideKey returns the store key prefix to retrieve all the orders on one side of a pair
+func OrderBookSideKey(
+	pairIndex string,
+	side OrderSide,
+) []byte {
+	var key []byte
+
+	// the pair index is length prefixed as denoms may contain any separator
+	key = append(key, lengthPrefix(pairIndex)...)
+	key = append(key, byte(side))
+
+	return key
+}
+
+// OrderKey returns the store key to retrieve an Order from the index fields. On each side of a pair,
+// keys are sorted by price then time priority: iterating the sell side forward and the buy side in
+// reverse visits the orders in price-time priority
+func OrderKey(
+	pairIndex string,
+	side OrderSide,
+	price sdk.Dec,
+	timePriority int32,
+) []byte {
+	key := OrderBookSideKey(pairIndex, side)
+
+	key = append(key, priceKey(price)...)
+
+	// bids are iterated in reverse, their priorities are complemented to keep older orders first
+	if side == BuySide {
+		key = append(key, orderIDBytes(^timePriority)...)
+	} else {
+		key = append(key, orderIDBytes(timePriority)...)
+	}
+
+	return key
+}
+
+// TimePriority returns the position of an order among the orders at its price: its sequence once its
+// hidden reserve refilled it, its ID otherwise
+func (o Order) TimePriority() int32 {
+	if o.Sequence != 0 {
+		return o.Sequence
+	}
+
+	return o.Id
+}
+
+// TimePriority returns the time priority of the located order, as keyed in the order store
+func (l OrderLocation) TimePriority() int32 {
+	if l.Sequence != 0 {
+		return l.Sequence
+	}
+
+	return l.Id
+}
+
+// OrderIDIndexKey returns the store key to retrieve the location of an order from its pair, side and ID
+func OrderIDIndexKey(
+	pairIndex string,
+	side OrderSide,
+	id int32,
+) []byte {
+	key := OrderBookSideKey(pairIndex, side)
+	key = append(key, orderIDBytes(id)...)
+
+	return key
+}
+
+// OrderCreatorKey returns the store key prefix to retrieve the location of all the orders of a creator
+func OrderCreatorKey(
+	creator string,
+) []byte {
+	return lengthPrefix(creator)
+}
+
+// OrderCreatorIndexKey returns the store key to retrieve the location of an order from its creator,
+// pair, side and ID
+func OrderCreatorIndexKey(
+	creator string,
+	pairIndex string,
+	side OrderSide,
+	id int32,
+) []byte {
+	key := OrderCreatorKey(creator)
+	key = append(key, OrderIDIndexKey(pairIndex, side, id)...)
+
+	return key
+}
+
+// OrderExpiryTimestampKey returns the store key prefix of the orders expiring at a timestamp in the
+// expiry queue
+func OrderExpiryTimestampKey(
+	timestamp uint64,
+) []byte {
+	key := append([]byte{}, orderExpiryTimestampKey...)
+	key = append(key, sdk.Uint64ToBigEndian(timestamp)...)
+
+	return key
+}
+
+// OrderExpiryHeightKey returns the store key prefix of the orders expiring at a height in the expiry
+// queue
+func OrderExpiryHeightKey(
+	height int64,
+) []byte {
+	key := append([]byte{}, orderExpiryHeightKey...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+
+	return key
+}
+
+// OrderExpiryQueueKeys returns the store keys of an order in the expiry queue, one for each set field
+// of its expiry
+func OrderExpiryQueueKeys(
+	pairIndex string,
+	side OrderSide,
+	id int32,
+	expiry OrderExpiry,
+) (keys [][]byte) {
+	if expiry.Timestamp != 0 {
+		keys = append(keys, append(OrderExpiryTimestampKey(expiry.Timestamp), OrderIDIndexKey(pairIndex, side, id)...))
+	}
+	if expiry.Height != 0 {
+		keys = append(keys, append(OrderExpiryHeightKey(expiry.Height), OrderIDIndexKey(pairIndex, side, id)...))
+	}
+
+	return keys
+}
+
+// priceKey returns the big endian and length prefixed bytes of a price so that byte order matches
+// numerical order
+func priceKey(price sdk.Dec) []byte {
+	priceBytes := price.BigInt().Bytes()
+
+	return append([]byte{byte(len(priceBytes))}, priceBytes...)
+}
+
+func lengthPrefix(s string) []byte {
+	bz := make([]byte, 2, 2+len(s))
+	binary.BigEndian.PutUint16(bz, uint16(len(s)))
+
+	return append(bz, s...)
+}
+
+func orderIDBytes(id int32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(id))
+
+	return bz
+}
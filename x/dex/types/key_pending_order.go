@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PendingOrderKeyPrefix is the prefix to retrieve all PendingOrder
+	PendingOrderKeyPrefix = "PendingOrder/value/"
+
+	// PendingOrderAccountKeyPrefix is the prefix of the index of the pending orders by creator
+	PendingOrderAccountKeyPrefix = "PendingOrder/account/"
+)
+
+// PendingOrderKey returns the store key to retrieve a PendingOrder from the port, channel and sequence
+// of its packet
+func PendingOrderKey(
+	port string,
+	channel string,
+	sequence uint64,
+) []byte {
+	key := lengthPrefix(port)
+	key = append(key, lengthPrefix(channel)...)
+	key = append(key, sdk.Uint64ToBigEndian(sequence)...)
+
+	return key
+}
+
+// PendingOrderAccountKey returns the store key prefix of the pending orders of an account in the account
+// index
+func PendingOrderAccountKey(
+	account string,
+) []byte {
+	return lengthPrefix(account)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StopOrderKey is the prefix to retrieve all StopOrder
+	StopOrderKey = "StopOrder/value/"
+
+	// StopOrderCountKey is the key of the number of stop orders ever placed
+	StopOrderCountKey = "StopOrder/count/"
+
+	// StopOrderTriggerKeyPrefix is the prefix of the index of the stop orders by pair, side and stop
+	// price
+	StopOrderTriggerKeyPrefix = "StopOrder/trigger/"
+)
+
+// StopOrderIDKey returns the store key to retrieve a StopOrder from its ID
+func StopOrderIDKey(
+	id uint64,
+) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// StopOrderTriggerPriceKey returns the store key prefix of the stop orders on one side of a pair with a
+// stop price. Keys are sorted by stop price
+func StopOrderTriggerPriceKey(
+	pairIndex string,
+	side OrderSide,
+	stopPrice sdk.Dec,
+) []byte {
+	key := OrderBookSideKey(pairIndex, side)
+	key = append(key, priceKey(stopPrice)...)
+
+	return key
+}
+
+// StopOrderTriggerKey returns the store key of a stop order in the trigger index
+func StopOrderTriggerKey(
+	pairIndex string,
+	side OrderSide,
+	stopPrice sdk.Dec,
+	id uint64,
+) []byte {
+	key := StopOrderTriggerPriceKey(pairIndex, side, stopPrice)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+
+	return key
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TradeKey is the prefix to retrieve all Trade
+	TradeKey = "Trade/value/"
+
+	// TradeCountKey is the key of the number of trades ever recorded
+	TradeCountKey = "Trade/count/"
+
+	// TradePairKeyPrefix is the prefix of the index of the trades by pair
+	TradePairKeyPrefix = "Trade/pair/"
+
+	// TradeAccountKeyPrefix is the prefix of the index of the trades by maker and taker address
+	TradeAccountKeyPrefix = "Trade/account/"
+)
+
+// TradeIDKey returns the store key to retrieve a Trade from its ID
+func TradeIDKey(
+	id uint64,
+) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// TradePairKey returns the store key prefix of the trades of a pair in the pair index
+func TradePairKey(
+	pairIndex string,
+) []byte {
+	return lengthPrefix(pairIndex)
+}
+
+// TradeAccountKey returns the store key prefix of the trades of an account in the account index
+func TradeAccountKey(
+	account string,
+) []byte {
+	return lengthPrefix(account)
+}
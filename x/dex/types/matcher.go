@@ -0,0 +1,107 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Matcher allocates the amount of an incoming order among the resting orders of the best price level
+type Matcher interface {
+	// Allocate splits an amount among the orders of a price level given in time priority, allocating
+	// the smaller of the amount and the total amount of the level
+	Allocate(level []Order, amount sdk.Int) []sdk.Int
+
+	// SharesLevel returns whether all the orders of a price level take part in each fill, so that the
+	// self-trades of the whole level are prevented before matching
+	SharesLevel() bool
+}
+
+var (
+	_ Matcher = PriceTimeMatcher{}
+	_ Matcher = ProRataMatcher{}
+)
+
+// Matcher returns the matcher of the matching algorithm of the pair
+func (r PairRules) Matcher() Matcher {
+	if r.MatchingAlgorithm == ProRata {
+		return ProRataMatcher{
+			LotSize:       r.LotSize,
+			MinAllocation: r.MinAllocation,
+		}
+	}
+
+	return PriceTimeMatcher{}
+}
+
+// PriceTimeMatcher fills the orders of a price level one after the other, oldest first
+type PriceTimeMatcher struct{}
+
+func (PriceTimeMatcher) Allocate(level []Order, amount sdk.Int) []sdk.Int {
+	allocations := make([]sdk.Int, len(level))
+	left := amount
+	for i, order := range level {
+		allocations[i] = sdk.MinInt(left, order.Amount)
+		left = left.Sub(allocations[i])
+	}
+
+	return allocations
+}
+
+func (PriceTimeMatcher) SharesLevel() bool {
+	return false
+}
+
+// ProRataMatcher shares an amount among the orders of a price level in proportion to their amounts.
+// Allocations are rounded down to the lot size and the ones below the minimum allocation are dropped,
+// the remainder is then allocated in time priority
+type ProRataMatcher struct {
+	LotSize       sdk.Int
+	MinAllocation sdk.Int
+}
+
+func (m ProRataMatcher) Allocate(level []Order, amount sdk.Int) []sdk.Int {
+	allocations := make([]sdk.Int, len(level))
+	total := sdk.ZeroInt()
+	for _, order := range level {
+		total = total.Add(order.Amount)
+	}
+
+	// the whole level is filled
+	if amount.GTE(total) {
+		for i, order := range level {
+			allocations[i] = order.Amount
+		}
+		return allocations
+	}
+
+	allocated := sdk.ZeroInt()
+	for i, order := range level {
+		allocation := amount.Mul(order.Amount).Quo(total)
+		if isRuleSet(m.LotSize) {
+			allocation = allocation.Sub(allocation.Mod(m.LotSize))
+		}
+		if isRuleSet(m.MinAllocation) && allocation.LT(m.MinAllocation) {
+			allocation = sdk.ZeroInt()
+		}
+
+		allocations[i] = allocation
+		allocated = allocated.Add(allocation)
+	}
+
+	// the remainder goes to the oldest orders first
+	remainder := amount.Sub(allocated)
+	for i, order := range level {
+		if remainder.IsZero() {
+			break
+		}
+
+		extra := sdk.MinInt(remainder, order.Amount.Sub(allocations[i]))
+		allocations[i] = allocations[i].Add(extra)
+		remainder = remainder.Sub(extra)
+	}
+
+	return allocations
+}
+
+func (ProRataMatcher) SharesLevel() bool {
+	return true
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+var testLevel = []types.Order{
+	{Id: 0, Amount: sdk.NewInt(100)},
+	{Id: 1, Amount: sdk.NewInt(300)},
+	{Id: 2, Amount: sdk.NewInt(20)},
+}
+
+func requireAllocations(t *testing.T, expected []int64, allocations []sdk.Int) {
+	require.Len(t, allocations, len(expected))
+	for i, amount := range expected {
+		require.True(t, sdk.NewInt(amount).Equal(allocations[i]), "allocation %d: %s", i, allocations[i])
+	}
+}
+
+func TestPairRulesMatcher(t *testing.T) {
+	require.Equal(t, types.PriceTimeMatcher{}, types.PairRules{}.Matcher())
+
+	rules := testPairRules
+	rules.MatchingAlgorithm = types.ProRata
+	rules.MinAllocation = sdk.NewInt(30)
+	require.Equal(t, types.ProRataMatcher{LotSize: rules.LotSize, MinAllocation: rules.MinAllocation}, rules.Matcher())
+}
+
+func TestPriceTimeMatcherAllocate(t *testing.T) {
+	matcher := types.PriceTimeMatcher{}
+	require.False(t, matcher.SharesLevel())
+
+	requireAllocations(t, []int64{100, 150, 0}, matcher.Allocate(testLevel, sdk.NewInt(250)))
+	requireAllocations(t, []int64{100, 300, 20}, matcher.Allocate(testLevel, sdk.NewInt(1000)))
+}
+
+func TestProRataMatcherAllocate(t *testing.T) {
+	matcher := types.ProRataMatcher{}
+	require.True(t, matcher.SharesLevel())
+
+	// the shares are truncated and the remainder goes to the oldest orders
+	requireAllocations(t, []int64{50, 150, 10}, matcher.Allocate(testLevel, sdk.NewInt(210)))
+	requireAllocations(t, []int64{25, 70, 4}, matcher.Allocate(testLevel, sdk.NewInt(99)))
+
+	// the whole level is filled
+	requireAllocations(t, []int64{100, 300, 20}, matcher.Allocate(testLevel, sdk.NewInt(1000)))
+
+	// the shares are rounded down to the lot size
+	matcher.LotSize = sdk.NewInt(10)
+	requireAllocations(t, []int64{30, 70, 0}, matcher.Allocate(testLevel, sdk.NewInt(100)))
+
+	// the shares below the minimum allocation are dropped
+	matcher.MinAllocation = sdk.NewInt(30)
+	requireAllocations(t, []int64{30, 70, 0}, matcher.Allocate(testLevel, sdk.NewInt(100)))
+	requireAllocations(t, []int64{40, 0, 0}, matcher.Allocate(testLevel, sdk.NewInt(40)))
+}
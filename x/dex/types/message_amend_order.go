@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAmendOrder = "amend_order"
+
+var _ sdk.Msg = &MsgAmendOrder{}
+
+func NewMsgAmendOrder(
+	creator string,
+	port string,
+	channel string,
+	amountDenom string,
+	priceDenom string,
+	side OrderSide,
+	orderID int32,
+	amount sdk.Int,
+	price sdk.Dec,
+) *MsgAmendOrder {
+	return &MsgAmendOrder{
+		Creator:     creator,
+		Port:        port,
+		Channel:     channel,
+		AmountDenom: amountDenom,
+		PriceDenom:  priceDenom,
+		Side:        uint32(side),
+		OrderID:     orderID,
+		Amount:      amount,
+		Price:       price,
+	}
+}
+
+func (msg *MsgAmendOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAmendOrder) Type() string {
+	return TypeMsgAmendOrder
+}
+
+func (msg *MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAmendOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAmendOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Side != uint32(BuySide) && msg.Side != uint32(SellSide) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid side")
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid price")
+	}
+	return nil
+}
+
+// GetOrderSide returns the side of the order book of the amended order
+func (msg *MsgAmendOrder) GetOrderSide() OrderSide {
+	return OrderSide(msg.Side)
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/testutil/sample"
+)
+
+func TestMsgAmendOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAmendOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAmendOrder{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid side",
+			msg: MsgAmendOrder{
+				Creator: sample.AccAddress(),
+				Side:    257,
+				Amount:  sdk.NewInt(10),
+				Price:   sdk.NewDec(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid amount",
+			msg: MsgAmendOrder{
+				Creator: sample.AccAddress(),
+				Side:    uint32(BuySide),
+				Amount:  sdk.ZeroInt(),
+				Price:   sdk.NewDec(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid price",
+			msg: MsgAmendOrder{
+				Creator: sample.AccAddress(),
+				Side:    uint32(SellSide),
+				Amount:  sdk.NewInt(10),
+				Price:   sdk.ZeroDec(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgAmendOrder{
+				Creator: sample.AccAddress(),
+				Side:    uint32(SellSide),
+				Amount:  sdk.NewInt(10),
+				Price:   sdk.NewDec(10),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelStopOrder = "cancel_stop_order"
+
+var _ sdk.Msg = &MsgCancelStopOrder{}
+
+func NewMsgCancelStopOrder(creator string, id uint64) *MsgCancelStopOrder {
+	return &MsgCancelStopOrder{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelStopOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelStopOrder) Type() string {
+	return TypeMsgCancelStopOrder
+}
+
+func (msg *MsgCancelStopOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelStopOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelStopOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
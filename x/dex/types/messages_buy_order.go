@@ -15,19 +15,35 @@ func NewMsgSendBuyOrder(
 	channelID string,
 	timeoutTimestamp uint64,
 	amountDenom string,
-	amount int32,
+	amount sdk.Int,
 	priceDenom string,
-	price int32,
+	price sdk.Dec,
+	orderType OrderType,
+	timeInForce TimeInForce,
+	expiry OrderExpiry,
+	postOnly bool,
+	stopPrice sdk.Dec,
+	displayAmount sdk.Int,
+	selfTradePrevention SelfTradePrevention,
+	maxQuote sdk.Int,
 ) *MsgSendBuyOrder {
 	return &MsgSendBuyOrder{
-		Creator:          creator,
-		Port:             port,
-		ChannelID:        channelID,
-		TimeoutTimestamp: timeoutTimestamp,
-		AmountDenom:      amountDenom,
-		Amount:           amount,
-		PriceDenom:       priceDenom,
-		Price:            price,
+		Creator:             creator,
+		Port:                port,
+		ChannelID:           channelID,
+		TimeoutTimestamp:    timeoutTimestamp,
+		AmountDenom:         amountDenom,
+		Amount:              amount,
+		PriceDenom:          priceDenom,
+		Price:               price,
+		OrderType:           orderType,
+		TimeInForce:         timeInForce,
+		Expiry:              expiry,
+		PostOnly:            postOnly,
+		StopPrice:           stopPrice,
+		DisplayAmount:       displayAmount,
+		SelfTradePrevention: selfTradePrevention,
+		MaxQuote:            maxQuote,
 	}
 }
 
@@ -66,5 +82,62 @@ func (msg *MsgSendBuyOrder) ValidateBasic() error {
 	if msg.TimeoutTimestamp == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
 	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+	// market buy orders may omit their protection price, their maximum quote bounding the escrowed quote
+	if msg.Price.IsNil() || msg.Price.IsNegative() || (msg.Price.IsZero() && msg.OrderType != MarketOrder) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid price")
+	}
+	if err := ValidateMaxQuote(msg.Price, msg.MaxQuote); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Price.IsPositive() {
+		if err := ValidateQuote(msg.Amount, msg.Price); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if err := msg.OrderType.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.TimeInForce.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.Expiry.Validate(msg.OrderType, msg.TimeInForce); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidatePostOnly(msg.PostOnly, msg.OrderType, msg.TimeInForce); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateDisplayAmount(msg.DisplayAmount, msg.OrderType, msg.TimeInForce); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.SelfTradePrevention.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !msg.StopPrice.IsNil() && msg.StopPrice.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid stop price")
+	}
 	return nil
 }
+
+// ValidateRules checks the order against the trading rules of the pair, which are stored with its order
+// book and cannot be checked by ValidateBasic
+func (msg *MsgSendBuyOrder) ValidateRules(rules PairRules) error {
+	return rules.ValidateOrderType(msg.OrderType, msg.Amount, msg.Price)
+}
+
+// ValidateLimits checks the order against the maximum amount and price of the params of the module
+func (msg *MsgSendBuyOrder) ValidateLimits(params Params) error {
+	return params.ValidateOrderType(msg.OrderType, msg.Amount, msg.Price)
+}
+
+// EscrowAmount returns the quote escrowed for the order when it is sent
+func (msg *MsgSendBuyOrder) EscrowAmount() sdk.Int {
+	return buyEscrowAmount(msg.Amount, msg.Price, msg.MaxQuote)
+}
+
+// IsStopOrder returns whether the order is escrowed until the last price crosses its stop price
+func (msg *MsgSendBuyOrder) IsStopOrder() bool {
+	return !msg.StopPrice.IsNil() && msg.StopPrice.IsPositive()
+}
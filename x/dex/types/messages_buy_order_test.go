@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 	"interchange-nel/testutil/sample"
@@ -50,6 +51,264 @@ func TestMsgSendBuyOrder_ValidateBasic(t *testing.T) {
 				TimeoutTimestamp: 0,
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid amount",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.ZeroInt(),
+				Price:            sdk.NewDec(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid price",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.ZeroDec(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero quote amount",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(1),
+				Price:            sdk.NewDecWithPrec(5, 1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid order type",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				OrderType:        OrderType(2),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative protection price",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(-1),
+				OrderType:        MarketOrder,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "market order without protection price",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.ZeroDec(),
+				OrderType:        MarketOrder,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid market order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDecWithPrec(15, 1),
+				OrderType:        MarketOrder,
+			},
+		}, {
+			name: "invalid time in force",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      TimeInForce(3),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid fill-or-kill order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      FillOrKill,
+			},
+		}, {
+			name: "expiry of immediate-or-cancel order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      ImmediateOrCancel,
+				Expiry:           OrderExpiry{Height: 10},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid good-til-time order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				Expiry:           OrderExpiry{Timestamp: 100, Height: 10},
+			},
+		}, {
+			name: "post-only market order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				OrderType:        MarketOrder,
+				PostOnly:         true,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid post-only order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				PostOnly:         true,
+			},
+		}, {
+			name: "negative stop price",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				StopPrice:        sdk.NewDec(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid stop order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				StopPrice:        sdk.NewDec(9),
+			},
+		}, {
+			name: "immediate-or-cancel iceberg order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      ImmediateOrCancel,
+				DisplayAmount:    sdk.NewInt(5),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid iceberg order",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				DisplayAmount:    sdk.NewInt(5),
+			},
+		}, {
+			name: "invalid self-trade prevention",
+			msg: MsgSendBuyOrder{
+				Creator:             sample.AccAddress(),
+				Port:                "port",
+				ChannelID:           "channel-0",
+				TimeoutTimestamp:    100,
+				Amount:              sdk.NewInt(10),
+				Price:               sdk.NewDec(10),
+				SelfTradePrevention: SelfTradePrevention(3),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid decrement-both order",
+			msg: MsgSendBuyOrder{
+				Creator:             sample.AccAddress(),
+				Port:                "port",
+				ChannelID:           "channel-0",
+				TimeoutTimestamp:    100,
+				Amount:              sdk.NewInt(10),
+				Price:               sdk.NewDec(10),
+				SelfTradePrevention: DecrementBoth,
+			},
+		}, {
+			name: "market order without price nor max quote",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.ZeroDec(),
+				OrderType:        MarketOrder,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "max quote with price",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				OrderType:        MarketOrder,
+				MaxQuote:         sdk.NewInt(100),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid market order without price",
+			msg: MsgSendBuyOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.ZeroDec(),
+				OrderType:        MarketOrder,
+				MaxQuote:         sdk.NewInt(100),
+			},
 		}, {
 			name: "valid message",
 			msg: MsgSendBuyOrder{
@@ -57,6 +316,8 @@ func TestMsgSendBuyOrder_ValidateBasic(t *testing.T) {
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDecWithPrec(15, 1),
 			},
 		},
 	}
@@ -16,6 +16,7 @@ func NewMsgSendCreatePair(
 	timeoutTimestamp uint64,
 	sourceDenom string,
 	targetDenom string,
+	rules PairRules,
 ) *MsgSendCreatePair {
 	return &MsgSendCreatePair{
 		Creator:          creator,
@@ -24,6 +25,7 @@ func NewMsgSendCreatePair(
 		TimeoutTimestamp: timeoutTimestamp,
 		SourceDenom:      sourceDenom,
 		TargetDenom:      targetDenom,
+		Rules:            rules,
 	}
 }
 
@@ -62,5 +64,12 @@ func (msg *MsgSendCreatePair) ValidateBasic() error {
 	if msg.TimeoutTimestamp == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
 	}
+	if err := msg.Rules.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// the fees of a pair are set by governance, not by its creator
+	if msg.Rules.HasFeeOverrides() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrPairFeeOverride.Error())
+	}
 	return nil
 }
@@ -3,12 +3,14 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 	"interchange-nel/testutil/sample"
 )
 
 func TestMsgSendCreatePair_ValidateBasic(t *testing.T) {
+	feeRate := sdk.ZeroDec()
 	tests := []struct {
 		name string
 		msg  MsgSendCreatePair
@@ -50,6 +52,35 @@ func TestMsgSendCreatePair_ValidateBasic(t *testing.T) {
 				TimeoutTimestamp: 0,
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid rules",
+			msg: MsgSendCreatePair{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Rules:            PairRules{MinAmount: sdk.NewInt(20), MaxAmount: sdk.NewInt(10)},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "fee overrides",
+			msg: MsgSendCreatePair{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Rules:            PairRules{TakerFeeRate: &feeRate},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid rules",
+			msg: MsgSendCreatePair{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Rules:            PairRules{TickSize: sdk.NewDecWithPrec(1, 2), LotSize: sdk.NewInt(10)},
+			},
 		}, {
 			name: "valid message",
 			msg: MsgSendCreatePair{
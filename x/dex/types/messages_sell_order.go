@@ -15,19 +15,33 @@ func NewMsgSendSellOrder(
 	channelID string,
 	timeoutTimestamp uint64,
 	amountDenom string,
-	amount int32,
+	amount sdk.Int,
 	priceDenom string,
-	price int32,
+	price sdk.Dec,
+	orderType OrderType,
+	timeInForce TimeInForce,
+	expiry OrderExpiry,
+	postOnly bool,
+	stopPrice sdk.Dec,
+	displayAmount sdk.Int,
+	selfTradePrevention SelfTradePrevention,
 ) *MsgSendSellOrder {
 	return &MsgSendSellOrder{
-		Creator:          creator,
-		Port:             port,
-		ChannelID:        channelID,
-		TimeoutTimestamp: timeoutTimestamp,
-		AmountDenom:      amountDenom,
-		Amount:           amount,
-		PriceDenom:       priceDenom,
-		Price:            price,
+		Creator:             creator,
+		Port:                port,
+		ChannelID:           channelID,
+		TimeoutTimestamp:    timeoutTimestamp,
+		AmountDenom:         amountDenom,
+		Amount:              amount,
+		PriceDenom:          priceDenom,
+		Price:               price,
+		OrderType:           orderType,
+		TimeInForce:         timeInForce,
+		Expiry:              expiry,
+		PostOnly:            postOnly,
+		StopPrice:           stopPrice,
+		DisplayAmount:       displayAmount,
+		SelfTradePrevention: selfTradePrevention,
 	}
 }
 
@@ -66,5 +80,49 @@ func (msg *MsgSendSellOrder) ValidateBasic() error {
 	if msg.TimeoutTimestamp == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
 	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+	if err := msg.OrderType.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.TimeInForce.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.Expiry.Validate(msg.OrderType, msg.TimeInForce); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidatePostOnly(msg.PostOnly, msg.OrderType, msg.TimeInForce); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateDisplayAmount(msg.DisplayAmount, msg.OrderType, msg.TimeInForce); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.SelfTradePrevention.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !msg.StopPrice.IsNil() && msg.StopPrice.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid stop price")
+	}
+	// market sell orders may omit their protection price
+	if msg.Price.IsNil() || msg.Price.IsNegative() || (msg.Price.IsZero() && msg.OrderType != MarketOrder) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid price")
+	}
 	return nil
 }
+
+// ValidateRules checks the order against the trading rules of the pair, which are stored with its order
+// book and cannot be checked by ValidateBasic
+func (msg *MsgSendSellOrder) ValidateRules(rules PairRules) error {
+	return rules.ValidateOrderType(msg.OrderType, msg.Amount, msg.Price)
+}
+
+// ValidateLimits checks the order against the maximum amount and price of the params of the module
+func (msg *MsgSendSellOrder) ValidateLimits(params Params) error {
+	return params.ValidateOrderType(msg.OrderType, msg.Amount, msg.Price)
+}
+
+// IsStopOrder returns whether the order is escrowed until the last price crosses its stop price
+func (msg *MsgSendSellOrder) IsStopOrder() bool {
+	return !msg.StopPrice.IsNil() && msg.StopPrice.IsPositive()
+}
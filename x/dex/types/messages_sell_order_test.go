@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 	"interchange-nel/testutil/sample"
@@ -50,6 +51,215 @@ func TestMsgSendSellOrder_ValidateBasic(t *testing.T) {
 				TimeoutTimestamp: 0,
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid amount",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.ZeroInt(),
+				Price:            sdk.NewDec(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid price",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.ZeroDec(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid order type",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				OrderType:        OrderType(2),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative protection price",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(-1),
+				OrderType:        MarketOrder,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "market order without protection price",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.ZeroDec(),
+				OrderType:        MarketOrder,
+			},
+		}, {
+			name: "valid market order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDecWithPrec(15, 1),
+				OrderType:        MarketOrder,
+			},
+		}, {
+			name: "invalid time in force",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      TimeInForce(3),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid fill-or-kill order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      FillOrKill,
+			},
+		}, {
+			name: "expiry of immediate-or-cancel order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      ImmediateOrCancel,
+				Expiry:           OrderExpiry{Height: 10},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid good-til-time order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				Expiry:           OrderExpiry{Timestamp: 100, Height: 10},
+			},
+		}, {
+			name: "post-only market order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				OrderType:        MarketOrder,
+				PostOnly:         true,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid post-only order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				PostOnly:         true,
+			},
+		}, {
+			name: "negative stop price",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				StopPrice:        sdk.NewDec(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid stop order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				StopPrice:        sdk.NewDec(9),
+			},
+		}, {
+			name: "immediate-or-cancel iceberg order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				TimeInForce:      ImmediateOrCancel,
+				DisplayAmount:    sdk.NewInt(5),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid iceberg order",
+			msg: MsgSendSellOrder{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDec(10),
+				DisplayAmount:    sdk.NewInt(5),
+			},
+		}, {
+			name: "invalid self-trade prevention",
+			msg: MsgSendSellOrder{
+				Creator:             sample.AccAddress(),
+				Port:                "port",
+				ChannelID:           "channel-0",
+				TimeoutTimestamp:    100,
+				Amount:              sdk.NewInt(10),
+				Price:               sdk.NewDec(10),
+				SelfTradePrevention: SelfTradePrevention(3),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid decrement-both order",
+			msg: MsgSendSellOrder{
+				Creator:             sample.AccAddress(),
+				Port:                "port",
+				ChannelID:           "channel-0",
+				TimeoutTimestamp:    100,
+				Amount:              sdk.NewInt(10),
+				Price:               sdk.NewDec(10),
+				SelfTradePrevention: DecrementBoth,
+			},
 		}, {
 			name: "valid message",
 			msg: MsgSendSellOrder{
@@ -57,6 +267,8 @@ func TestMsgSendSellOrder_ValidateBasic(t *testing.T) {
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 100,
+				Amount:           sdk.NewInt(10),
+				Price:            sdk.NewDecWithPrec(15, 1),
 			},
 		},
 	}
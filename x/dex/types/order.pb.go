@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -22,6 +24,100 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OrderType defines how an order is executed
+type OrderType int32
+
+const (
+	// limit orders rest on the book for their unfilled amount
+	LimitOrder OrderType = 0
+	// market orders sweep the counterparty book up to their protection price, their unfilled amount is
+	// refunded
+	MarketOrder OrderType = 1
+)
+
+var OrderType_name = map[int32]string{
+	0: "ORDER_TYPE_LIMIT",
+	1: "ORDER_TYPE_MARKET",
+}
+
+var OrderType_value = map[string]int32{
+	"ORDER_TYPE_LIMIT":  0,
+	"ORDER_TYPE_MARKET": 1,
+}
+
+func (x OrderType) String() string {
+	return proto.EnumName(OrderType_name, int32(x))
+}
+
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{0}
+}
+
+// TimeInForce defines how long the unfilled amount of an order remains active
+type TimeInForce int32
+
+const (
+	// good-til-cancelled orders rest on the book until filled or cancelled
+	GoodTilCancelled TimeInForce = 0
+	// immediate-or-cancel orders are refunded the amount not filled on reception
+	ImmediateOrCancel TimeInForce = 1
+	// fill-or-kill orders are rejected unless their whole amount is filled on reception
+	FillOrKill TimeInForce = 2
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "TIME_IN_FORCE_GTC",
+	1: "TIME_IN_FORCE_IOC",
+	2: "TIME_IN_FORCE_FOK",
+}
+
+var TimeInForce_value = map[string]int32{
+	"TIME_IN_FORCE_GTC": 0,
+	"TIME_IN_FORCE_IOC": 1,
+	"TIME_IN_FORCE_FOK": 2,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{1}
+}
+
+// SelfTradePrevention defines how an incoming order matching a resting order of its own creator is
+// handled
+type SelfTradePrevention int32
+
+const (
+	// the resting order is cancelled and refunded, the incoming order keeps matching
+	CancelResting SelfTradePrevention = 0
+	// the remaining amount of the incoming order is cancelled and refunded
+	CancelIncoming SelfTradePrevention = 1
+	// both orders are decremented by the smaller of their amounts, which is refunded
+	DecrementBoth SelfTradePrevention = 2
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_CANCEL_RESTING",
+	1: "SELF_TRADE_PREVENTION_CANCEL_INCOMING",
+	2: "SELF_TRADE_PREVENTION_DECREMENT_BOTH",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_CANCEL_RESTING":  0,
+	"SELF_TRADE_PREVENTION_CANCEL_INCOMING": 1,
+	"SELF_TRADE_PREVENTION_DECREMENT_BOTH":  2,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{2}
+}
+
 type OrderBook struct {
 	IdCount int32    `protobuf:"varint,1,opt,name=idCount,proto3" json:"idCount,omitempty"`
 	Orders  []*Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -75,10 +171,17 @@ func (m *OrderBook) GetOrders() []*Order {
 }
 
 type Order struct {
-	Id      int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Amount  int32  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price   int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Id      int32                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string                                 `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Price   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Expiry  OrderExpiry                            `protobuf:"bytes,5,opt,name=expiry,proto3" json:"expiry"`
+	// originalAmount is the amount of the order when placed, unset for the orders placed before it was
+	// recorded
+	OriginalAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=originalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"originalAmount,omitempty"`
+	// sequence is the time priority of the order at its price once its hidden reserve refilled it,
+	// allocated like the order IDs, zero for the orders ordered by their ID
+	Sequence int32 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -128,44 +231,345 @@ func (m *Order) GetCreator() string {
 	return ""
 }
 
-func (m *Order) GetAmount() int32 {
+func (m *Order) GetExpiry() OrderExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return OrderExpiry{}
+}
+
+func (m *Order) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// OrderExpiry is the optional expiry of an order resting on the book, the order expires as soon as
+// one of its set fields is reached
+type OrderExpiry struct {
+	// timestamp is the block time in unix nanoseconds the order expires at, zero for none
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// height is the block height the order expires at, zero for none
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OrderExpiry) Reset()         { *m = OrderExpiry{} }
+func (m *OrderExpiry) String() string { return proto.CompactTextString(m) }
+func (*OrderExpiry) ProtoMessage()    {}
+func (*OrderExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{2}
+}
+func (m *OrderExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderExpiry.Merge(m, src)
+}
+func (m *OrderExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderExpiry proto.InternalMessageInfo
+
+func (m *OrderExpiry) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *OrderExpiry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// OrderLocation locates an order in the order store, it is the value of the order indexes
+type OrderLocation struct {
+	PairIndex string                                 `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Side      uint32                                 `protobuf:"varint,2,opt,name=side,proto3" json:"side,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Id        int32                                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is the time priority of the order at its price, zero for the orders ordered by their ID
+	Sequence int32 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *OrderLocation) Reset()         { *m = OrderLocation{} }
+func (m *OrderLocation) String() string { return proto.CompactTextString(m) }
+func (*OrderLocation) ProtoMessage()    {}
+func (*OrderLocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{3}
+}
+func (m *OrderLocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderLocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderLocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderLocation.Merge(m, src)
+}
+func (m *OrderLocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderLocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderLocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderLocation proto.InternalMessageInfo
+
+func (m *OrderLocation) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *OrderLocation) GetSide() uint32 {
+	if m != nil {
+		return m.Side
+	}
+	return 0
+}
+
+func (m *OrderLocation) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OrderLocation) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// OpenOrder is an order resting on a book of this chain, as listed for its creator
+type OpenOrder struct {
+	PairIndex string `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Side      uint32 `protobuf:"varint,2,opt,name=side,proto3" json:"side,omitempty"`
+	OrderId   int32  `protobuf:"varint,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	// amount is the remaining amount of the order, its hidden reserve included
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// originalAmount is the amount of the order when placed
+	OriginalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=originalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"originalAmount"`
+}
+
+func (m *OpenOrder) Reset()         { *m = OpenOrder{} }
+func (m *OpenOrder) String() string { return proto.CompactTextString(m) }
+func (*OpenOrder) ProtoMessage()    {}
+func (*OpenOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{4}
+}
+func (m *OpenOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpenOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenOrder.Merge(m, src)
+}
+func (m *OpenOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenOrder proto.InternalMessageInfo
+
+func (m *OpenOrder) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *OpenOrder) GetSide() uint32 {
+	if m != nil {
+		return m.Side
+	}
+	return 0
+}
+
+func (m *OpenOrder) GetOrderId() int32 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+// OrderReserve is the hidden reserve of an iceberg order, it refills the visible slice of the order on
+// the book once filled
+type OrderReserve struct {
+	PairIndex     string                                 `protobuf:"bytes,1,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	Side          uint32                                 `protobuf:"varint,2,opt,name=side,proto3" json:"side,omitempty"`
+	Id            int32                                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=displayAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"displayAmount"`
+	Reserve       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve"`
+}
+
+func (m *OrderReserve) Reset()         { *m = OrderReserve{} }
+func (m *OrderReserve) String() string { return proto.CompactTextString(m) }
+func (*OrderReserve) ProtoMessage()    {}
+func (*OrderReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{5}
+}
+func (m *OrderReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReserve.Merge(m, src)
+}
+func (m *OrderReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderReserve proto.InternalMessageInfo
+
+func (m *OrderReserve) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *OrderReserve) GetSide() uint32 {
 	if m != nil {
-		return m.Amount
+		return m.Side
 	}
 	return 0
 }
 
-func (m *Order) GetPrice() int32 {
+func (m *OrderReserve) GetId() int32 {
 	if m != nil {
-		return m.Price
+		return m.Id
 	}
 	return 0
 }
 
 func init() {
+	proto.RegisterEnum("interchangenel.dex.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("interchangenel.dex.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("interchangenel.dex.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
 	proto.RegisterType((*OrderBook)(nil), "interchangenel.dex.OrderBook")
 	proto.RegisterType((*Order)(nil), "interchangenel.dex.Order")
+	proto.RegisterType((*OrderExpiry)(nil), "interchangenel.dex.OrderExpiry")
+	proto.RegisterType((*OrderLocation)(nil), "interchangenel.dex.OrderLocation")
+	proto.RegisterType((*OpenOrder)(nil), "interchangenel.dex.OpenOrder")
+	proto.RegisterType((*OrderReserve)(nil), "interchangenel.dex.OrderReserve")
 }
 
 func init() { proto.RegisterFile("dex/order.proto", fileDescriptor_c2d5fab85368797d) }
 
 var fileDescriptor_c2d5fab85368797d = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x49, 0xad, 0xd0,
-	0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xcd, 0x4b, 0xcd, 0xd1, 0x4b, 0x49, 0xad, 0x50,
-	0x8a, 0xe0, 0xe2, 0xf4, 0x07, 0x29, 0x71, 0xca, 0xcf, 0xcf, 0x16, 0x92, 0xe0, 0x62, 0xcf, 0x4c,
-	0x71, 0xce, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x0d, 0x82, 0x71, 0x85, 0x0c,
-	0xb9, 0xd8, 0xc0, 0x26, 0x15, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x49, 0xea, 0x61, 0x9a,
-	0xa5, 0x07, 0x36, 0x28, 0x08, 0xaa, 0x50, 0x29, 0x9e, 0x8b, 0x15, 0x2c, 0x20, 0xc4, 0xc7, 0xc5,
-	0x94, 0x99, 0x02, 0x35, 0x90, 0x29, 0x33, 0x05, 0x64, 0x4b, 0x72, 0x51, 0x6a, 0x62, 0x49, 0x7e,
-	0x91, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x24, 0xc6, 0xc5, 0x96, 0x98, 0x0b,
-	0xb6, 0x9e, 0x19, 0xac, 0x1a, 0xca, 0x13, 0x12, 0xe1, 0x62, 0x2d, 0x28, 0xca, 0x4c, 0x4e, 0x95,
-	0x60, 0x01, 0x0b, 0x43, 0x38, 0x4e, 0xa6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x25, 0x8d, 0xe4, 0x38, 0xdd, 0xbc, 0xd4, 0x1c, 0xfd, 0x0a, 0x7d, 0x50, 0x58, 0x94, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc3, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x86, 0x21,
-	0xbb, 0xd9, 0x1f, 0x01, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xd3, 0x24, 0x25, 0x13, 0xda, 0x75, 0x87, 0x05, 0x19, 0x83, 0x52, 0x2b, 0xda, 0x5d,
+	0x55, 0x85, 0x4d, 0xc4, 0x22, 0x4e, 0x68, 0x0f, 0x89, 0xe3, 0x74, 0xad, 0x26, 0x71, 0x35, 0xb5,
+	0x56, 0xc0, 0xc5, 0xf2, 0xda, 0x8f, 0x64, 0x54, 0x7b, 0xc6, 0x8c, 0xdd, 0x55, 0xfa, 0x0f, 0x50,
+	0x4e, 0x5c, 0x38, 0xe6, 0xc4, 0x4f, 0xe0, 0xcc, 0x7d, 0x8f, 0x7b, 0xe0, 0x80, 0x38, 0xac, 0x50,
+	0x7b, 0x47, 0xfc, 0x04, 0xe4, 0x71, 0xda, 0x86, 0xa0, 0x22, 0x75, 0xcb, 0xc9, 0x7e, 0xe3, 0xf7,
+	0x7d, 0xef, 0x7b, 0x6f, 0x3e, 0xcf, 0xa0, 0x7b, 0x21, 0xcc, 0x3a, 0x5c, 0x84, 0x20, 0xda, 0x89,
+	0xe0, 0x19, 0xc7, 0x98, 0xb2, 0x0c, 0x44, 0x30, 0xf5, 0xd9, 0x04, 0x18, 0x44, 0xed, 0x10, 0x66,
+	0xfa, 0xfd, 0x09, 0x9f, 0x70, 0xf9, 0xb9, 0x93, 0xbf, 0x15, 0x99, 0xad, 0xaf, 0x50, 0xdd, 0xc9,
+	0x81, 0x3d, 0xce, 0x4f, 0xb0, 0x86, 0x36, 0x69, 0x68, 0xf2, 0x53, 0x96, 0x69, 0x8a, 0xa1, 0xec,
+	0x55, 0xc9, 0x65, 0x88, 0x3f, 0x43, 0x35, 0xc9, 0x9f, 0x6a, 0x65, 0x63, 0x63, 0xaf, 0xf1, 0xe4,
+	0xc3, 0xf6, 0xbf, 0x2b, 0xb4, 0x25, 0x11, 0x59, 0x26, 0xb6, 0xfe, 0x2c, 0xa3, 0xaa, 0x5c, 0xc1,
+	0xdb, 0xa8, 0x4c, 0xc3, 0x25, 0x63, 0x99, 0x86, 0x79, 0x99, 0x40, 0x80, 0x9f, 0x71, 0xa1, 0x95,
+	0x0d, 0x65, 0xaf, 0x4e, 0x2e, 0x43, 0x3c, 0x40, 0x35, 0x3f, 0x96, 0xf5, 0x37, 0xf2, 0x0f, 0xbd,
+	0xf6, 0xab, 0x37, 0xbb, 0xa5, 0xdf, 0xdf, 0xec, 0x3e, 0x9a, 0xd0, 0x6c, 0x7a, 0xfa, 0xa2, 0x1d,
+	0xf0, 0xb8, 0x13, 0xf0, 0x34, 0xe6, 0xe9, 0xf2, 0xf1, 0x38, 0x0d, 0x4f, 0x3a, 0xd9, 0x59, 0x02,
+	0x69, 0xdb, 0x66, 0x19, 0x59, 0xa2, 0x71, 0x1f, 0x55, 0x13, 0x41, 0x03, 0xd0, 0x2a, 0xb7, 0xa6,
+	0xe9, 0x43, 0x40, 0x0a, 0x30, 0x7e, 0x8a, 0x6a, 0x30, 0x4b, 0xa8, 0x38, 0xd3, 0xaa, 0x86, 0xb2,
+	0xd7, 0x78, 0xb2, 0x7b, 0x63, 0xd3, 0x96, 0x4c, 0xeb, 0x55, 0xf2, 0x3a, 0x64, 0x09, 0xc2, 0x04,
+	0x6d, 0x73, 0x41, 0x27, 0x94, 0xf9, 0x51, 0xb7, 0x68, 0xaa, 0x26, 0xd5, 0xec, 0xdf, 0xa2, 0xa1,
+	0x35, 0x06, 0xac, 0xa3, 0x77, 0x52, 0xf8, 0xee, 0x14, 0x58, 0x00, 0xda, 0xa6, 0x1c, 0xe8, 0x55,
+	0xdc, 0x32, 0x51, 0x63, 0x45, 0x0c, 0xfe, 0x18, 0xd5, 0x33, 0x1a, 0x43, 0x9a, 0xf9, 0x71, 0x22,
+	0x87, 0x5f, 0x21, 0xd7, 0x0b, 0xf8, 0x03, 0x54, 0x9b, 0x02, 0x9d, 0x4c, 0x33, 0xb9, 0x05, 0x1b,
+	0x64, 0x19, 0xb5, 0x7e, 0x56, 0xd0, 0x96, 0x64, 0x19, 0xf2, 0xc0, 0xcf, 0x28, 0x67, 0x39, 0x4f,
+	0xe2, 0x53, 0x61, 0xb3, 0x10, 0x66, 0x92, 0xa7, 0x4e, 0xae, 0x17, 0x30, 0x46, 0x95, 0x94, 0x86,
+	0x20, 0x59, 0xb6, 0x88, 0x7c, 0xbf, 0x9e, 0xfe, 0xc6, 0x5d, 0xa6, 0x5f, 0xb8, 0xa6, 0x72, 0xe5,
+	0x9a, 0xd5, 0xd6, 0xab, 0x6b, 0xad, 0xff, 0x52, 0x46, 0x75, 0x27, 0x01, 0x56, 0xf8, 0xed, 0xf6,
+	0x8a, 0x35, 0xb4, 0x29, 0x5d, 0x6b, 0x87, 0x52, 0x73, 0x95, 0x5c, 0x86, 0x2b, 0x8e, 0xac, 0xfc,
+	0x3f, 0x8e, 0xac, 0xde, 0x65, 0x26, 0xcf, 0x6f, 0xb0, 0xd4, 0x6d, 0x55, 0xad, 0xb1, 0xb4, 0xfe,
+	0x52, 0xd0, 0xbb, 0xc5, 0xdf, 0x0b, 0x29, 0x88, 0x97, 0xf0, 0x16, 0x23, 0x2c, 0xb6, 0x6b, 0xe3,
+	0x6a, 0xbb, 0x5c, 0xb4, 0x15, 0xd2, 0x34, 0x89, 0xfc, 0xb3, 0xee, 0x5d, 0xe6, 0xf7, 0x4f, 0x12,
+	0xfc, 0x0c, 0x6d, 0x8a, 0x42, 0xa2, 0x56, 0x7d, 0x2b, 0xbe, 0x4b, 0xf8, 0xbe, 0xb7, 0x3c, 0xf8,
+	0xdc, 0xb3, 0x04, 0xf0, 0x03, 0xa4, 0x3a, 0xa4, 0x6f, 0x11, 0xcf, 0xfd, 0xfa, 0xc8, 0xf2, 0x86,
+	0xf6, 0xc8, 0x76, 0xd5, 0x92, 0xbe, 0x3d, 0x5f, 0x18, 0x68, 0x48, 0x63, 0x9a, 0x15, 0xbe, 0x7a,
+	0x84, 0x76, 0x56, 0xb2, 0x46, 0x5d, 0x72, 0x68, 0xb9, 0xaa, 0xa2, 0xdf, 0x9b, 0x2f, 0x8c, 0xc6,
+	0xc8, 0x17, 0x27, 0x50, 0xe4, 0xe9, 0x95, 0xef, 0x7f, 0x6a, 0x96, 0xf6, 0x7f, 0x54, 0x50, 0xc3,
+	0xa5, 0x31, 0xd8, 0x6c, 0xc0, 0x45, 0x00, 0xf8, 0x13, 0xb4, 0xe3, 0xda, 0x23, 0xcb, 0xb3, 0xc7,
+	0xde, 0xc0, 0x21, 0xa6, 0xe5, 0x1d, 0xb8, 0xa6, 0x5a, 0xd2, 0xef, 0xcf, 0x17, 0x86, 0x7a, 0xc0,
+	0x79, 0xe8, 0xd2, 0xc8, 0xf4, 0x59, 0x00, 0x51, 0x04, 0x21, 0xfe, 0x74, 0x3d, 0xd9, 0x76, 0x4c,
+	0x55, 0xd1, 0xdf, 0x9f, 0x2f, 0x8c, 0x1d, 0x3b, 0x8e, 0x21, 0xa4, 0x7e, 0x06, 0x8e, 0x28, 0x00,
+	0xf8, 0xe1, 0x7a, 0xf6, 0xc0, 0x39, 0x54, 0xcb, 0x85, 0xfe, 0x01, 0x8d, 0x22, 0x47, 0x1c, 0xd2,
+	0x28, 0x5a, 0xea, 0xfa, 0x55, 0x41, 0xef, 0x1d, 0x43, 0xf4, 0xad, 0x2b, 0xfc, 0x10, 0x8e, 0x04,
+	0xbc, 0x04, 0x26, 0xff, 0xf3, 0x2f, 0xd1, 0x83, 0x63, 0x6b, 0x38, 0xf0, 0x5c, 0xd2, 0xed, 0x5b,
+	0xde, 0x11, 0xb1, 0x9e, 0x5b, 0x63, 0xd7, 0x76, 0xc6, 0x9e, 0xd9, 0x1d, 0x9b, 0xd6, 0xd0, 0x23,
+	0xd6, 0xb1, 0x6b, 0x8f, 0x0f, 0xd4, 0x92, 0xbe, 0x33, 0x5f, 0x18, 0x5b, 0x45, 0x69, 0x02, 0x69,
+	0x46, 0xd9, 0x04, 0x3f, 0x45, 0x0f, 0xff, 0x13, 0x6c, 0x8f, 0x4d, 0x67, 0x94, 0xa3, 0x15, 0x1d,
+	0xcf, 0x17, 0xc6, 0x76, 0x81, 0xb6, 0x59, 0xc0, 0xe3, 0x1c, 0x7e, 0x63, 0xed, 0xbe, 0x65, 0x12,
+	0x6b, 0x64, 0x8d, 0x5d, 0xaf, 0xe7, 0xb8, 0xcf, 0xd4, 0x72, 0x51, 0xbb, 0x0f, 0x81, 0x80, 0x18,
+	0x58, 0xd6, 0xe3, 0xd9, 0xb4, 0x68, 0xab, 0xf7, 0xc5, 0xab, 0xf3, 0xa6, 0xf2, 0xfa, 0xbc, 0xa9,
+	0xfc, 0x71, 0xde, 0x54, 0x7e, 0xb8, 0x68, 0x96, 0x5e, 0x5f, 0x34, 0x4b, 0xbf, 0x5d, 0x34, 0x4b,
+	0xdf, 0x7c, 0xb4, 0x72, 0x6a, 0x3f, 0x66, 0x10, 0x75, 0x66, 0x9d, 0xfc, 0xbe, 0x94, 0x9e, 0x78,
+	0x51, 0x93, 0xd7, 0xe0, 0xe7, 0x7f, 0x0f, 0x00, 0x28, 0x4e, 0x7b, 0x06, 0x43, 0x07, 0x00, 0x00,
 }
 
 func (m *OrderBook) Marshal() (dAtA []byte, err error) {
@@ -230,16 +634,53 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Price != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.Price))
+	if m.Sequence != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Sequence))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x38
 	}
-	if m.Amount != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
+	if m.OriginalAmount != nil {
+		{
+			size := m.OriginalAmount.Size()
+			i -= size
+			if _, err := m.OriginalAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x32
 	}
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -255,54 +696,366 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOrder(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrderExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *OrderBook) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OrderExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IdCount != 0 {
-		n += 1 + sovOrder(uint64(m.IdCount))
+	if m.Height != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovOrder(uint64(l))
-		}
+	if m.Timestamp != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrderLocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderLocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Id != 0 {
-		n += 1 + sovOrder(uint64(m.Id))
+		i = encodeVarintOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovOrder(uint64(m.Amount))
+	i--
+	dAtA[i] = 0x1a
+	if m.Side != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OriginalAmount.Size()
+		i -= size
+		if _, err := m.OriginalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OrderId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Side != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DisplayAmount.Size()
+		i -= size
+		if _, err := m.DisplayAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Id != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Side != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IdCount != 0 {
+		n += 1 + sovOrder(uint64(m.IdCount))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Expiry.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.OriginalAmount != nil {
+		l = m.OriginalAmount.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOrder(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *OrderExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovOrder(uint64(m.Timestamp))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOrder(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *OrderLocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovOrder(uint64(m.Id))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOrder(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *OpenOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovOrder(uint64(m.OrderId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.OriginalAmount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *OrderReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
 	}
-	if m.Price != 0 {
-		n += 1 + sovOrder(uint64(m.Price))
+	if m.Id != 0 {
+		n += 1 + sovOrder(uint64(m.Id))
 	}
+	l = m.DisplayAmount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Reserve.Size()
+	n += 1 + l + sovOrder(uint64(l))
 	return n
 }
 
@@ -496,10 +1249,10 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -509,16 +1262,98 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Price = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAmount", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -528,11 +1363,718 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.OriginalAmount = &v
+			if err := m.OriginalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -2,7 +2,9 @@ package types
 
 import (
 	"errors"
-	"sort"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func NewOrderBook() OrderBook {
@@ -11,34 +13,30 @@ func NewOrderBook() OrderBook {
 	}
 }
 
-const (
-	MaxAmount = int32(100_000)
-	MaxPrice  = int32(100_000)
-)
-
-type Ordering int
-
-const (
-	Increasing Ordering = iota
-	Decreasing
-)
-
 var (
 	ErrMaxAmount     = errors.New("Max amount reached")
 	ErrMaxPrice      = errors.New("Max price reached")
 	ErrZeroAmount    = errors.New("Amount is zero")
 	ErrZeroPrice     = errors.New("Price is zero")
+	ErrZeroQuote     = errors.New("Quote amount is zero")
 	ErrOrderNotFound = errors.New("Order not found")
+	ErrAmendAmount   = errors.New("Amended amount exceeds the order amount")
+	ErrAmendNoChange = errors.New("Amended order is unchanged")
 )
 
-func (book *OrderBook) appendOrder(
+// NewOrder validates and initialises a new order with the next order ID of the book, its amount and
+// price being limited by the params and its quote amount being positive
+func (book *OrderBook) NewOrder(
+	params Params,
 	creator string,
-	amount int32,
-	price int32,
-	ordering Ordering,
-) (int32, error) {
-	if err := checkAmountAndPrice(amount, price); err != nil {
-		return 0, err
+	amount sdk.Int,
+	price sdk.Dec,
+) (Order, error) {
+	if err := params.ValidateOrder(amount, price); err != nil {
+		return Order{}, err
+	}
+	if err := ValidateQuote(amount, price); err != nil {
+		return Order{}, err
 	}
 
 	// initialise the order
@@ -47,88 +45,115 @@ func (book *OrderBook) appendOrder(
 	order.Creator = creator
 	order.Amount = amount
 	order.Price = price
+	order.OriginalAmount = &amount
 
 	// increment ID tracker
 	book.IncrementNextOrderID()
 
-	// insert the order
-	book.insertOrder(order, ordering)
-	return order.Id, nil
+	return order, nil
 }
 
-func checkAmountAndPrice(amount int32, price int32) error {
-	if amount == int32(0) {
+// ValidateOrders checks the orders of a book imported through genesis, as they are stored by time
+// priority and indexed by ID: their IDs and time priorities must be unique and allocated by the book, and
+// their amounts and prices positive
+func (book OrderBook) ValidateOrders() error {
+	ids := make(map[int32]struct{})
+	priorities := make(map[int32]struct{})
+	for _, order := range book.Orders {
+		if order == nil {
+			return ErrOrderNotFound
+		}
+		if _, ok := ids[order.Id]; ok {
+			return fmt.Errorf("duplicated order id %d", order.Id)
+		}
+		if order.Id < 0 || order.Id >= book.IdCount {
+			return fmt.Errorf("order id %d should be lower than the order count %d", order.Id, book.IdCount)
+		}
+		if order.Sequence < 0 || order.Sequence >= book.IdCount {
+			return fmt.Errorf("order sequence %d should be lower than the order count %d", order.Sequence, book.IdCount)
+		}
+		if _, ok := priorities[order.TimePriority()]; ok {
+			return fmt.Errorf("duplicated order time priority %d", order.TimePriority())
+		}
+		if order.Amount.IsNil() || !order.Amount.IsPositive() {
+			return ErrZeroAmount
+		}
+		if order.Price.IsNil() || !order.Price.IsPositive() {
+			return ErrZeroPrice
+		}
+		ids[order.Id] = struct{}{}
+		priorities[order.TimePriority()] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateAmount checks that an order amount is positive and within the maximum amount of the params
+func (p Params) ValidateAmount(amount sdk.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
 		return ErrZeroAmount
 	}
 
-	if amount > MaxAmount {
+	if amount.GT(p.MaxAmount) {
 		return ErrMaxAmount
 	}
 
-	if price == int32(0) {
+	return nil
+}
+
+// ValidatePrice checks that an order price is positive and within the maximum price of the params
+func (p Params) ValidatePrice(price sdk.Dec) error {
+	if price.IsNil() || !price.IsPositive() {
 		return ErrZeroPrice
 	}
 
-	if price > MaxPrice {
+	if price.GT(p.MaxPrice) {
 		return ErrMaxPrice
 	}
 
 	return nil
 }
 
-func (book OrderBook) GetNextOrderID() int32 {
-	return book.IdCount
-}
+// ValidateOrder checks the amount and price of an order resting on a book against the limits of the params
+func (p Params) ValidateOrder(amount sdk.Int, price sdk.Dec) error {
+	if err := p.ValidateAmount(amount); err != nil {
+		return err
+	}
 
-func (book *OrderBook) IncrementNextOrderID() {
-	// even numbers to have different ID than buy orders
-	book.IdCount++
+	return p.ValidatePrice(price)
 }
 
-func (book *OrderBook) insertOrder(order Order, ordering Ordering) {
-	if len(book.Orders) > 0 {
-		var i int
-
-		// get the index of the new order, depending on the provided ordering
-		if ordering == Increasing {
-			i = sort.Search(
-				len(book.Orders),
-				func(i int) bool { return book.Orders[i].Price > order.Price },
-			)
-		} else {
-			i = sort.Search(
-				len(book.Orders),
-				func(i int) bool { return book.Orders[i].Price < order.Price },
-			)
-		}
-
-		// insert the order
-		orders := append(book.Orders, &order)
-		copy(orders[i+1:], orders[i:])
-		orders[i] = &order
-		book.Orders = orders
-	} else {
-		book.Orders = append(book.Orders, &order)
+// ValidateOrderType checks an order against the limits of the params, the price of market orders being
+// only a protection price that never rests on a book
+func (p Params) ValidateOrderType(orderType OrderType, amount sdk.Int, price sdk.Dec) error {
+	if orderType == MarketOrder {
+		return p.ValidateAmount(amount)
 	}
+
+	return p.ValidateOrder(amount, price)
 }
 
-func (book OrderBook) GetOrderFromID(id int32) (Order, error) {
-	for _, order := range book.Orders {
-		if order.Id == id {
-			return *order, nil
-		}
+// ValidateQuote checks that the quote amount of an order is positive, as a buy order escrowing nothing or
+// a sell order paid nothing must not rest on a book
+func ValidateQuote(amount sdk.Int, price sdk.Dec) error {
+	if !QuoteAmount(amount, price).IsPositive() {
+		return ErrZeroQuote
 	}
 
-	return Order{}, ErrOrderNotFound
+	return nil
 }
 
-func (book *OrderBook) RemoveOrderFromID(id int32) error {
-	for i, order := range book.Orders {
-		if order.Id == id {
-			book.Orders = append(book.Orders[:i], book.Orders[i+1:]...)
-			return nil
-		}
-	}
+// QuoteAmount returns the amount of price denom corresponding to amount at price, truncated so that
+// the quote paid out never exceeds the quote escrowed
+func QuoteAmount(amount sdk.Int, price sdk.Dec) sdk.Int {
+	return price.MulInt(amount).TruncateInt()
+}
 
-	return ErrOrderNotFound
+func (book OrderBook) GetNextOrderID() int32 {
+	return book.IdCount
+}
+
+func (book *OrderBook) IncrementNextOrderID() {
+	// even numbers to have different ID than buy orders
+	book.IdCount++
 }
@@ -32,19 +32,19 @@ func GenLocalAccount() string {
 	return GenAddress()
 }
 
-func GenAmount() int32 {
-	return int32(rand.Intn(int(types.MaxAmount)) + 1)
+func GenAmount() sdk.Int {
+	return sdk.NewInt(rand.Int63n(1_000_000_000_000) + 1)
 }
 
-func GenPrice() int32 {
-	return int32(rand.Intn(int(types.MaxPrice)) + 1)
+func GenPrice() sdk.Dec {
+	return sdk.NewDecWithPrec(rand.Int63n(1_000_000_000_000)+1, 6)
 }
 
 func GenPair() (string, string) {
 	return GenString(10), GenString(10)
 }
 
-func GenOrder() (string, int32, int32) {
+func GenOrder() (string, sdk.Int, sdk.Dec) {
 	return GenLocalAccount(), GenAmount(), GenPrice()
 }
 
@@ -52,68 +52,71 @@ func MockAccount(str string) string {
 	return str
 }
 
-func OrderListToOrderBook(list []types.Order) types.OrderBook {
-	listCopy := make([]*types.Order, len(list))
-
-	for i, order := range list {
-		order := order
-		listCopy[i] = &order
+func TestNewOrder(t *testing.T) {
+	book := types.NewOrderBook()
+	params := types.DefaultParams()
+
+	// prevent zero amount
+	creator, amount, price := GenOrder()
+	_, err := book.NewOrder(params, creator, sdk.ZeroInt(), price)
+	require.ErrorIs(t, err, types.ErrZeroAmount)
+
+	// prevent big amount
+	_, err = book.NewOrder(params, creator, params.MaxAmount.AddRaw(1), price)
+	require.ErrorIs(t, err, types.ErrMaxAmount)
+
+	// prevent zero price
+	_, err = book.NewOrder(params, creator, amount, sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrZeroPrice)
+
+	// prevent big price
+	_, err = book.NewOrder(params, creator, amount, params.MaxPrice.Add(sdk.OneDec()))
+	require.ErrorIs(t, err, types.ErrMaxPrice)
+
+	// prevent zero quote amount
+	_, err = book.NewOrder(params, creator, sdk.NewInt(1), sdk.NewDecWithPrec(5, 1))
+	require.ErrorIs(t, err, types.ErrZeroQuote)
+
+	// rejected orders do not consume IDs
+	require.Equal(t, int32(0), book.GetNextOrderID())
+
+	for i := int32(0); i < 5; i++ {
+		creator, amount, price := GenOrder()
+		order, err := book.NewOrder(params, creator, amount, price)
+		require.NoError(t, err)
+		require.Equal(t, types.Order{
+			Id:             i,
+			Creator:        creator,
+			Amount:         amount,
+			Price:          price,
+			OriginalAmount: &amount,
+		}, order)
 	}
+	require.Equal(t, int32(5), book.GetNextOrderID())
 
-	return types.OrderBook{
-		IdCount: 0,
-		Orders:  listCopy,
-	}
+	// new orders are not added to the book, they are stored by the keeper
+	require.Empty(t, book.Orders)
 }
 
-func TestRemoveOrderFromID(t *testing.T) {
-	inputList := []types.Order{
-		{Id: 3, Creator: MockAccount("3"), Amount: 2, Price: 10},
-		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
-		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
-		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
-	}
-	book := OrderListToOrderBook(inputList)
-
-	expectedList := []types.Order{
-		{Id: 3, Creator: MockAccount("3"), Amount: 2, Price: 10},
-		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
-		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
-	}
-	expectedBook := OrderListToOrderBook(expectedList)
-
-	err := book.RemoveOrderFromID(2)
-	require.NoError(t, err)
-	require.Equal(t, expectedBook, book)
-
-	book = OrderListToOrderBook(inputList)
+func TestParamsValidateOrderType(t *testing.T) {
+	params := types.DefaultParams()
 
-	expectedList = []types.Order{
-		{Id: 3, Creator: MockAccount("3"), Amount: 2, Price: 10},
-		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
-		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
-	}
-	expectedBook = OrderListToOrderBook(expectedList)
-
-	err = book.RemoveOrderFromID(0)
-	require.NoError(t, err)
-	require.Equal(t, expectedBook, book)
-
-	book = OrderListToOrderBook(inputList)
-
-	expectedList = []types.Order{
-		{Id: 2, Creator: MockAccount("2"), Amount: 30, Price: 15},
-		{Id: 1, Creator: MockAccount("1"), Amount: 200, Price: 20},
-		{Id: 0, Creator: MockAccount("0"), Amount: 50, Price: 25},
-	}
-	expectedBook = OrderListToOrderBook(expectedList)
+	// the protection price of market orders is not checked
+	require.NoError(t, params.ValidateOrderType(types.MarketOrder, sdk.NewInt(20), params.MaxPrice.Add(sdk.OneDec())))
+	require.ErrorIs(t, params.ValidateOrderType(types.MarketOrder, params.MaxAmount.AddRaw(1), sdk.ZeroDec()), types.ErrMaxAmount)
+	require.ErrorIs(t, params.ValidateOrderType(types.LimitOrder, sdk.NewInt(20), params.MaxPrice.Add(sdk.OneDec())), types.ErrMaxPrice)
+	require.NoError(t, params.ValidateOrderType(types.LimitOrder, params.MaxAmount, params.MaxPrice))
+}
 
-	err = book.RemoveOrderFromID(3)
-	require.NoError(t, err)
-	require.Equal(t, expectedBook, book)
+func TestQuoteAmount(t *testing.T) {
+	// whole prices
+	require.Equal(t, sdk.NewInt(750), types.QuoteAmount(sdk.NewInt(30), sdk.NewDec(25)))
 
-	book = OrderListToOrderBook(inputList)
+	// fractional prices are truncated
+	require.Equal(t, sdk.NewInt(3), types.QuoteAmount(sdk.NewInt(7), sdk.NewDecWithPrec(5, 1)))
 
-	err = book.RemoveOrderFromID(4)
-	require.ErrorIs(t, err, types.ErrOrderNotFound)
+	// amounts beyond int32 do not overflow
+	amount := sdk.NewIntWithDecimal(5, 18)
+	price := sdk.NewDecWithPrec(25, 1)
+	require.Equal(t, sdk.NewIntWithDecimal(125, 17), types.QuoteAmount(amount, price))
 }
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidExpiry = errors.New("invalid order expiry")
+	ErrOrderExpired  = errors.New("order expiry has already been reached")
+)
+
+// IsSet returns whether the order has an expiry
+func (e OrderExpiry) IsSet() bool {
+	return e.Timestamp != 0 || e.Height != 0
+}
+
+// IsExpired returns whether the expiry is reached at a block time and height
+func (e OrderExpiry) IsExpired(blockTime time.Time, blockHeight int64) bool {
+	if e.Timestamp != 0 && uint64(blockTime.UnixNano()) >= e.Timestamp {
+		return true
+	}
+
+	return e.Height != 0 && blockHeight >= e.Height
+}
+
+// Validate checks that an expiry is only set on orders resting on the book
+func (e OrderExpiry) Validate(orderType OrderType, timeInForce TimeInForce) error {
+	if e.Height < 0 {
+		return fmt.Errorf("%w: negative height", ErrInvalidExpiry)
+	}
+
+	if e.IsSet() && !RestsOnBook(orderType, timeInForce) {
+		return fmt.Errorf("%w: only good-til-cancelled limit orders can expire", ErrInvalidExpiry)
+	}
+
+	return nil
+}
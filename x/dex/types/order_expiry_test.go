@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestOrderExpiry_IsExpired(t *testing.T) {
+	now := time.Unix(1_000, 0)
+
+	// no expiry
+	expiry := types.OrderExpiry{}
+	require.False(t, expiry.IsSet())
+	require.False(t, expiry.IsExpired(now, 10))
+
+	// timestamp
+	expiry = types.OrderExpiry{Timestamp: uint64(now.UnixNano())}
+	require.True(t, expiry.IsSet())
+	require.False(t, expiry.IsExpired(now.Add(-time.Nanosecond), 10))
+	require.True(t, expiry.IsExpired(now, 10))
+
+	// height
+	expiry = types.OrderExpiry{Height: 10}
+	require.True(t, expiry.IsSet())
+	require.False(t, expiry.IsExpired(now, 9))
+	require.True(t, expiry.IsExpired(now, 10))
+
+	// whichever comes first
+	expiry = types.OrderExpiry{Timestamp: uint64(now.UnixNano()), Height: 10}
+	require.True(t, expiry.IsExpired(now, 9))
+	require.True(t, expiry.IsExpired(now.Add(-time.Nanosecond), 10))
+	require.False(t, expiry.IsExpired(now.Add(-time.Nanosecond), 9))
+}
+
+func TestOrderExpiry_Validate(t *testing.T) {
+	expiry := types.OrderExpiry{Height: 10}
+	require.NoError(t, expiry.Validate(types.LimitOrder, types.GoodTilCancelled))
+	require.ErrorIs(t, expiry.Validate(types.LimitOrder, types.ImmediateOrCancel), types.ErrInvalidExpiry)
+	require.ErrorIs(t, expiry.Validate(types.MarketOrder, types.GoodTilCancelled), types.ErrInvalidExpiry)
+
+	// orders without expiry are always valid
+	require.NoError(t, types.OrderExpiry{}.Validate(types.MarketOrder, types.FillOrKill))
+
+	require.ErrorIs(t, types.OrderExpiry{Height: -1}.Validate(types.LimitOrder, types.GoodTilCancelled), types.ErrInvalidExpiry)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ErrInvalidDisplayAmount = errors.New("invalid display amount")
+	ErrInvalidIceberg       = errors.New("only good-til-cancelled limit orders can be iceberg orders")
+)
+
+// IsIceberg returns whether an order with a display amount only shows part of its amount on the book
+func IsIceberg(displayAmount sdk.Int) bool {
+	return !displayAmount.IsNil() && displayAmount.IsPositive()
+}
+
+// ValidateDisplayAmount checks the display amount of an order, only orders resting on the book can hide
+// part of their amount
+func ValidateDisplayAmount(displayAmount sdk.Int, orderType OrderType, timeInForce TimeInForce) error {
+	if displayAmount.IsNil() || displayAmount.IsZero() {
+		return nil
+	}
+
+	if displayAmount.IsNegative() {
+		return ErrInvalidDisplayAmount
+	}
+
+	if !RestsOnBook(orderType, timeInForce) {
+		return ErrInvalidIceberg
+	}
+
+	return nil
+}
+
+// SplitIcebergAmount splits the amount of an order into the slice visible on the book and the hidden
+// reserve
+func SplitIcebergAmount(amount sdk.Int, displayAmount sdk.Int) (visible sdk.Int, reserve sdk.Int) {
+	if !IsIceberg(displayAmount) || displayAmount.GTE(amount) {
+		return amount, sdk.ZeroInt()
+	}
+
+	return displayAmount, amount.Sub(displayAmount)
+}
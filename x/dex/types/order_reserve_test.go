@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestSplitIcebergAmount(t *testing.T) {
+	visible, reserve := types.SplitIcebergAmount(sdk.NewInt(100), sdk.NewInt(30))
+	require.True(t, sdk.NewInt(30).Equal(visible))
+	require.True(t, sdk.NewInt(70).Equal(reserve))
+
+	// orders without display amount or smaller than it show their whole amount
+	for _, displayAmount := range []sdk.Int{{}, sdk.ZeroInt(), sdk.NewInt(100), sdk.NewInt(200)} {
+		visible, reserve = types.SplitIcebergAmount(sdk.NewInt(100), displayAmount)
+		require.True(t, sdk.NewInt(100).Equal(visible))
+		require.True(t, reserve.IsZero())
+	}
+}
+
+func TestValidateDisplayAmount(t *testing.T) {
+	require.NoError(t, types.ValidateDisplayAmount(sdk.Int{}, types.MarketOrder, types.FillOrKill))
+	require.NoError(t, types.ValidateDisplayAmount(sdk.ZeroInt(), types.MarketOrder, types.FillOrKill))
+	require.NoError(t, types.ValidateDisplayAmount(sdk.NewInt(10), types.LimitOrder, types.GoodTilCancelled))
+	require.ErrorIs(t, types.ValidateDisplayAmount(sdk.NewInt(-1), types.LimitOrder, types.GoodTilCancelled), types.ErrInvalidDisplayAmount)
+	require.ErrorIs(t, types.ValidateDisplayAmount(sdk.NewInt(10), types.LimitOrder, types.ImmediateOrCancel), types.ErrInvalidIceberg)
+	require.ErrorIs(t, types.ValidateDisplayAmount(sdk.NewInt(10), types.MarketOrder, types.GoodTilCancelled), types.ErrInvalidIceberg)
+}
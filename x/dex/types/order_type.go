@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ErrInvalidOrderType   = errors.New("invalid order type")
+	ErrInvalidTimeInForce = errors.New("invalid time in force")
+	ErrFillOrKill         = errors.New("fill-or-kill order cannot be entirely filled")
+	ErrInvalidPostOnly    = errors.New("only good-til-cancelled limit orders can be post-only")
+	ErrPostOnlyWouldMatch = errors.New("post-only order would match immediately")
+	ErrMaxQuoteRequired   = errors.New("market buy orders without protection price require a maximum quote")
+	ErrMaxQuoteWithPrice  = errors.New("only market buy orders without protection price have a maximum quote")
+	ErrMaxQuoteTooLow     = errors.New("maximum quote affords none of the asks")
+)
+
+// Validate checks that the order type is known
+func (t OrderType) Validate() error {
+	if _, ok := OrderType_name[int32(t)]; !ok {
+		return ErrInvalidOrderType
+	}
+
+	return nil
+}
+
+// Validate checks that the time in force is known
+func (t TimeInForce) Validate() error {
+	if _, ok := TimeInForce_name[int32(t)]; !ok {
+		return ErrInvalidTimeInForce
+	}
+
+	return nil
+}
+
+// RestsOnBook returns whether the unfilled amount of an order is appended to the order book, otherwise
+// it is refunded
+func RestsOnBook(orderType OrderType, timeInForce TimeInForce) bool {
+	return orderType == LimitOrder && timeInForce == GoodTilCancelled
+}
+
+// ValidatePostOnly checks that only orders resting on the book are post-only
+func ValidatePostOnly(postOnly bool, orderType OrderType, timeInForce TimeInForce) error {
+	if postOnly && !RestsOnBook(orderType, timeInForce) {
+		return ErrInvalidPostOnly
+	}
+
+	return nil
+}
+
+// ValidateMaxQuote checks that the market buy orders without protection price, and only them, have a
+// positive maximum quote, escrowed instead of the quote of their amount at their price
+func ValidateMaxQuote(price sdk.Dec, maxQuote sdk.Int) error {
+	hasMaxQuote := !maxQuote.IsNil() && !maxQuote.IsZero()
+	if price.IsZero() {
+		if !hasMaxQuote || maxQuote.IsNegative() {
+			return ErrMaxQuoteRequired
+		}
+		return nil
+	}
+
+	if hasMaxQuote {
+		return ErrMaxQuoteWithPrice
+	}
+
+	return nil
+}
+
+// buyEscrowAmount returns the quote escrowed by a buy order: its amount at its limit or protection price,
+// or its maximum quote without protection price
+func buyEscrowAmount(amount sdk.Int, price sdk.Dec, maxQuote sdk.Int) sdk.Int {
+	if price.IsZero() {
+		return maxQuote
+	}
+
+	return QuoteAmount(amount, price)
+}
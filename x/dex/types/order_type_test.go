@@ -0,0 +1,22 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestRestsOnBook(t *testing.T) {
+	require.True(t, types.RestsOnBook(types.LimitOrder, types.GoodTilCancelled))
+	require.False(t, types.RestsOnBook(types.LimitOrder, types.ImmediateOrCancel))
+	require.False(t, types.RestsOnBook(types.LimitOrder, types.FillOrKill))
+	require.False(t, types.RestsOnBook(types.MarketOrder, types.GoodTilCancelled))
+}
+
+func TestValidatePostOnly(t *testing.T) {
+	require.NoError(t, types.ValidatePostOnly(true, types.LimitOrder, types.GoodTilCancelled))
+	require.NoError(t, types.ValidatePostOnly(false, types.MarketOrder, types.FillOrKill))
+	require.ErrorIs(t, types.ValidatePostOnly(true, types.MarketOrder, types.GoodTilCancelled), types.ErrInvalidPostOnly)
+	require.ErrorIs(t, types.ValidatePostOnly(true, types.LimitOrder, types.ImmediateOrCancel), types.ErrInvalidPostOnly)
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -171,8 +173,9 @@ var xxx_messageInfo_NoData proto.InternalMessageInfo
 
 // CreatePairPacketData defines a struct for the packet payload
 type CreatePairPacketData struct {
-	SourceDenom string `protobuf:"bytes,1,opt,name=sourceDenom,proto3" json:"sourceDenom,omitempty"`
-	TargetDenom string `protobuf:"bytes,2,opt,name=targetDenom,proto3" json:"targetDenom,omitempty"`
+	SourceDenom string    `protobuf:"bytes,1,opt,name=sourceDenom,proto3" json:"sourceDenom,omitempty"`
+	TargetDenom string    `protobuf:"bytes,2,opt,name=targetDenom,proto3" json:"targetDenom,omitempty"`
+	Rules       PairRules `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules"`
 }
 
 func (m *CreatePairPacketData) Reset()         { *m = CreatePairPacketData{} }
@@ -222,6 +225,13 @@ func (m *CreatePairPacketData) GetTargetDenom() string {
 	return ""
 }
 
+func (m *CreatePairPacketData) GetRules() PairRules {
+	if m != nil {
+		return m.Rules
+	}
+	return PairRules{}
+}
+
 // CreatePairPacketAck defines a struct for the packet acknowledgment
 type CreatePairPacketAck struct {
 }
@@ -261,11 +271,17 @@ var xxx_messageInfo_CreatePairPacketAck proto.InternalMessageInfo
 
 // SellOrderPacketData defines a struct for the packet payload
 type SellOrderPacketData struct {
-	AmountDenom string `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
-	Amount      int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	PriceDenom  string `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
-	Price       int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Seller      string `protobuf:"bytes,5,opt,name=seller,proto3" json:"seller,omitempty"`
+	AmountDenom         string                                 `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	PriceDenom          string                                 `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Seller              string                                 `protobuf:"bytes,5,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderType           OrderType                              `protobuf:"varint,6,opt,name=orderType,proto3,enum=interchangenel.dex.OrderType" json:"orderType,omitempty"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,7,opt,name=timeInForce,proto3,enum=interchangenel.dex.TimeInForce" json:"timeInForce,omitempty"`
+	Expiry              OrderExpiry                            `protobuf:"bytes,8,opt,name=expiry,proto3" json:"expiry"`
+	PostOnly            bool                                   `protobuf:"varint,9,opt,name=postOnly,proto3" json:"postOnly,omitempty"`
+	DisplayAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=displayAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"displayAmount"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,11,opt,name=selfTradePrevention,proto3,enum=interchangenel.dex.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
 }
 
 func (m *SellOrderPacketData) Reset()         { *m = SellOrderPacketData{} }
@@ -308,38 +324,70 @@ func (m *SellOrderPacketData) GetAmountDenom() string {
 	return ""
 }
 
-func (m *SellOrderPacketData) GetAmount() int32 {
+func (m *SellOrderPacketData) GetPriceDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.PriceDenom
 	}
-	return 0
+	return ""
 }
 
-func (m *SellOrderPacketData) GetPriceDenom() string {
+func (m *SellOrderPacketData) GetSeller() string {
 	if m != nil {
-		return m.PriceDenom
+		return m.Seller
 	}
 	return ""
 }
 
-func (m *SellOrderPacketData) GetPrice() int32 {
+func (m *SellOrderPacketData) GetOrderType() OrderType {
 	if m != nil {
-		return m.Price
+		return m.OrderType
 	}
-	return 0
+	return LimitOrder
 }
 
-func (m *SellOrderPacketData) GetSeller() string {
+func (m *SellOrderPacketData) GetTimeInForce() TimeInForce {
 	if m != nil {
-		return m.Seller
+		return m.TimeInForce
 	}
-	return ""
+	return GoodTilCancelled
+}
+
+func (m *SellOrderPacketData) GetExpiry() OrderExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return OrderExpiry{}
+}
+
+func (m *SellOrderPacketData) GetPostOnly() bool {
+	if m != nil {
+		return m.PostOnly
+	}
+	return false
+}
+
+func (m *SellOrderPacketData) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return CancelResting
 }
 
 // SellOrderPacketAck defines a struct for the packet acknowledgment
 type SellOrderPacketAck struct {
-	RemainingAmount int32 `protobuf:"varint,1,opt,name=remainingAmount,proto3" json:"remainingAmount,omitempty"`
-	Gain            int32 `protobuf:"varint,2,opt,name=gain,proto3" json:"gain,omitempty"`
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remainingAmount"`
+	Gain            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=gain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gain"`
+	// cancelledAmount is the amount of the order cancelled by self-trade prevention
+	CancelledAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=cancelledAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cancelledAmount"`
+	// fills are the liquidated parts of the resting orders, recorded as trades on the source chain
+	Fills []Order `protobuf:"bytes,4,rep,name=fills,proto3" json:"fills"`
+	// height and timestamp are the ones of the block executing the order
+	Height    int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// makerFee is the fee paid in amount denom by the resting orders filled
+	MakerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=makerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"makerFee"`
+	// takerFee is the fee deducted from the gain when it is paid on the source chain
+	TakerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"takerFee"`
 }
 
 func (m *SellOrderPacketAck) Reset()         { *m = SellOrderPacketAck{} }
@@ -375,27 +423,43 @@ func (m *SellOrderPacketAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SellOrderPacketAck proto.InternalMessageInfo
 
-func (m *SellOrderPacketAck) GetRemainingAmount() int32 {
+func (m *SellOrderPacketAck) GetFills() []Order {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *SellOrderPacketAck) GetHeight() int64 {
 	if m != nil {
-		return m.RemainingAmount
+		return m.Height
 	}
 	return 0
 }
 
-func (m *SellOrderPacketAck) GetGain() int32 {
+func (m *SellOrderPacketAck) GetTimestamp() uint64 {
 	if m != nil {
-		return m.Gain
+		return m.Timestamp
 	}
 	return 0
 }
 
 // BuyOrderPacketData defines a struct for the packet payload
 type BuyOrderPacketData struct {
-	AmountDenom string `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
-	Amount      int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	PriceDenom  string `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
-	Price       int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Buyer       string `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	AmountDenom         string                                 `protobuf:"bytes,1,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	PriceDenom          string                                 `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Buyer               string                                 `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	OrderType           OrderType                              `protobuf:"varint,6,opt,name=orderType,proto3,enum=interchangenel.dex.OrderType" json:"orderType,omitempty"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,7,opt,name=timeInForce,proto3,enum=interchangenel.dex.TimeInForce" json:"timeInForce,omitempty"`
+	Expiry              OrderExpiry                            `protobuf:"bytes,8,opt,name=expiry,proto3" json:"expiry"`
+	PostOnly            bool                                   `protobuf:"varint,9,opt,name=postOnly,proto3" json:"postOnly,omitempty"`
+	DisplayAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=displayAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"displayAmount"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,11,opt,name=selfTradePrevention,proto3,enum=interchangenel.dex.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
+	// maxQuote is the quote escrowed and spent at most by market orders without protection price, zero for
+	// the other orders
+	MaxQuote github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=maxQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxQuote"`
 }
 
 func (m *BuyOrderPacketData) Reset()         { *m = BuyOrderPacketData{} }
@@ -438,38 +502,72 @@ func (m *BuyOrderPacketData) GetAmountDenom() string {
 	return ""
 }
 
-func (m *BuyOrderPacketData) GetAmount() int32 {
+func (m *BuyOrderPacketData) GetPriceDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.PriceDenom
 	}
-	return 0
+	return ""
 }
 
-func (m *BuyOrderPacketData) GetPriceDenom() string {
+func (m *BuyOrderPacketData) GetBuyer() string {
 	if m != nil {
-		return m.PriceDenom
+		return m.Buyer
 	}
 	return ""
 }
 
-func (m *BuyOrderPacketData) GetPrice() int32 {
+func (m *BuyOrderPacketData) GetOrderType() OrderType {
 	if m != nil {
-		return m.Price
+		return m.OrderType
 	}
-	return 0
+	return LimitOrder
 }
 
-func (m *BuyOrderPacketData) GetBuyer() string {
+func (m *BuyOrderPacketData) GetTimeInForce() TimeInForce {
 	if m != nil {
-		return m.Buyer
+		return m.TimeInForce
 	}
-	return ""
+	return GoodTilCancelled
+}
+
+func (m *BuyOrderPacketData) GetExpiry() OrderExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return OrderExpiry{}
+}
+
+func (m *BuyOrderPacketData) GetPostOnly() bool {
+	if m != nil {
+		return m.PostOnly
+	}
+	return false
+}
+
+func (m *BuyOrderPacketData) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return CancelResting
 }
 
 // BuyOrderPacketAck defines a struct for the packet acknowledgment
 type BuyOrderPacketAck struct {
-	RemainingAmount int32 `protobuf:"varint,1,opt,name=remainingAmount,proto3" json:"remainingAmount,omitempty"`
-	Purchase        int32 `protobuf:"varint,2,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remainingAmount"`
+	Purchase        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=purchase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"purchase"`
+	// quote is the amount of price denom actually spent at the execution prices
+	Quote github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=quote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote"`
+	// cancelledAmount is the amount of the order cancelled by self-trade prevention
+	CancelledAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=cancelledAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cancelledAmount"`
+	// fills are the liquidated parts of the resting orders, recorded as trades on the source chain
+	Fills []Order `protobuf:"bytes,5,rep,name=fills,proto3" json:"fills"`
+	// height and timestamp are the ones of the block executing the order
+	Height    int64  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp uint64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// makerFee is the fee paid in price denom by the resting orders filled
+	MakerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=makerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"makerFee"`
+	// takerFee is the fee deducted from the purchase when it is paid on the source chain
+	TakerFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"takerFee"`
 }
 
 func (m *BuyOrderPacketAck) Reset()         { *m = BuyOrderPacketAck{} }
@@ -505,16 +603,23 @@ func (m *BuyOrderPacketAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BuyOrderPacketAck proto.InternalMessageInfo
 
-func (m *BuyOrderPacketAck) GetRemainingAmount() int32 {
+func (m *BuyOrderPacketAck) GetFills() []Order {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *BuyOrderPacketAck) GetHeight() int64 {
 	if m != nil {
-		return m.RemainingAmount
+		return m.Height
 	}
 	return 0
 }
 
-func (m *BuyOrderPacketAck) GetPurchase() int32 {
+func (m *BuyOrderPacketAck) GetTimestamp() uint64 {
 	if m != nil {
-		return m.Purchase
+		return m.Timestamp
 	}
 	return 0
 }
@@ -533,35 +638,59 @@ func init() {
 func init() { proto.RegisterFile("dex/packet.proto", fileDescriptor_9e40d3eecbdb512f) }
 
 var fileDescriptor_9e40d3eecbdb512f = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x6a, 0xdb, 0x40,
-	0x10, 0xc6, 0xbd, 0x4e, 0x24, 0xdc, 0x09, 0x6d, 0xd2, 0x8d, 0x5b, 0x4c, 0x0a, 0x22, 0xec, 0xa1,
-	0xf5, 0xa5, 0x32, 0xf4, 0xcf, 0x03, 0xd8, 0xf5, 0xa1, 0xa7, 0xd6, 0x28, 0x50, 0x68, 0x6e, 0x6b,
-	0x79, 0x50, 0x85, 0xe5, 0x95, 0x58, 0xad, 0x40, 0x7e, 0x8b, 0x9e, 0x0b, 0x7d, 0x9f, 0x9e, 0x4a,
-	0x8e, 0x3d, 0x16, 0xfb, 0x45, 0x8a, 0x76, 0x37, 0x46, 0x92, 0x75, 0xe9, 0x25, 0x37, 0x7d, 0xc3,
-	0xf7, 0xfd, 0x76, 0x76, 0x34, 0x2c, 0x5c, 0xac, 0xb0, 0x9c, 0x64, 0x3c, 0x5c, 0xa3, 0xf2, 0x33,
-	0x99, 0xaa, 0x94, 0xd2, 0x58, 0x28, 0x94, 0xe1, 0x37, 0x2e, 0x22, 0x14, 0x98, 0xf8, 0x2b, 0x2c,
-	0xd9, 0xef, 0x3e, 0x3c, 0x9e, 0x63, 0xb9, 0xd0, 0xbe, 0x39, 0x57, 0x9c, 0xbe, 0x03, 0x57, 0xa4,
-	0xd5, 0xd7, 0x88, 0x5c, 0x93, 0xf1, 0xd9, 0x9b, 0x2b, 0xff, 0x38, 0xe6, 0x7f, 0xd2, 0x8e, 0x8f,
-	0xbd, 0xc0, 0x7a, 0xe9, 0x02, 0x9e, 0x2c, 0x8b, 0xed, 0x67, 0xb9, 0x42, 0x69, 0x58, 0xa3, 0x53,
-	0x9d, 0x7e, 0xd9, 0x95, 0x9e, 0x35, 0x9c, 0x96, 0xd4, 0xca, 0xd3, 0x1b, 0x38, 0xcf, 0x31, 0x49,
-	0xea, 0xc8, 0x13, 0x8d, 0x7c, 0xd5, 0x85, 0xbc, 0x69, 0x5a, 0x2d, 0xb3, 0x4d, 0xa0, 0x5f, 0xe0,
-	0x22, 0x94, 0xc8, 0x15, 0x2e, 0x78, 0x7c, 0x4f, 0xed, 0x6b, 0xea, 0xb8, 0x8b, 0xfa, 0xa1, 0xe5,
-	0xb5, 0xd8, 0x23, 0xc6, 0x6c, 0x00, 0xae, 0x19, 0x35, 0x1b, 0x80, 0x6b, 0x86, 0xc3, 0x6e, 0x61,
-	0xd8, 0x95, 0xa7, 0xd7, 0x70, 0x96, 0xa7, 0x85, 0x0c, 0x71, 0x8e, 0x22, 0xdd, 0xe8, 0x29, 0x3f,
-	0x0a, 0xea, 0xa5, 0xca, 0xa1, 0xb8, 0x8c, 0x50, 0x19, 0x47, 0xdf, 0x38, 0x6a, 0x25, 0xf6, 0x0c,
-	0x2e, 0xdb, 0xec, 0x69, 0xb8, 0x66, 0x3f, 0x09, 0x5c, 0x76, 0x4c, 0xa2, 0x02, 0xf2, 0x4d, 0x5a,
-	0x08, 0xd5, 0x38, 0xb2, 0x56, 0xa2, 0xcf, 0xc1, 0x35, 0x52, 0x9f, 0xe6, 0x04, 0x56, 0x51, 0x0f,
-	0x20, 0x93, 0xf1, 0x7d, 0xaf, 0x27, 0x3a, 0x58, 0xab, 0xd0, 0x21, 0x38, 0x5a, 0xe9, 0xdf, 0xed,
-	0x04, 0x46, 0x54, 0xb4, 0x6a, 0xf2, 0x28, 0x47, 0x8e, 0x4e, 0x58, 0xc5, 0x02, 0xa0, 0xad, 0xf6,
-	0xa6, 0xe1, 0x9a, 0x8e, 0xe1, 0x5c, 0xe2, 0x86, 0xc7, 0x22, 0x16, 0xd1, 0xd4, 0x34, 0x41, 0x34,
-	0xad, 0x5d, 0xa6, 0x14, 0x4e, 0x23, 0x1e, 0x0b, 0xdb, 0xa3, 0xfe, 0x66, 0x3f, 0x08, 0xd0, 0xe3,
-	0x85, 0x7a, 0xf0, 0x2b, 0x0f, 0xc1, 0x59, 0x16, 0xdb, 0xc3, 0x8d, 0x8d, 0x60, 0x5f, 0xe1, 0x69,
-	0xb3, 0xb7, 0xff, 0xbb, 0xef, 0x15, 0x0c, 0xb2, 0xa2, 0xda, 0xc9, 0x1c, 0x6d, 0x93, 0x07, 0x3d,
-	0x7b, 0xff, 0x6b, 0xe7, 0x91, 0xbb, 0x9d, 0x47, 0xfe, 0xee, 0x3c, 0xf2, 0x7d, 0xef, 0xf5, 0xee,
-	0xf6, 0x5e, 0xef, 0xcf, 0xde, 0xeb, 0xdd, 0xbe, 0xa8, 0x6d, 0xf2, 0x6b, 0x81, 0xc9, 0xa4, 0x9c,
-	0x54, 0x6f, 0x81, 0xda, 0x66, 0x98, 0x2f, 0x5d, 0xfd, 0x16, 0xbc, 0xfd, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x64, 0x6b, 0x69, 0x94, 0x1f, 0x04, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x1b, 0xdb, 0xeb, 0xbc, 0xb0, 0xdb, 0x65, 0x5a, 0x90, 0x29, 0x90, 0x46, 0x3e, 0x2c,
+	0xb9, 0x6c, 0x22, 0x2d, 0xec, 0x01, 0x21, 0x0e, 0x0d, 0xa1, 0x62, 0xf7, 0x40, 0x8b, 0xb7, 0x42,
+	0xc0, 0x05, 0xb9, 0xce, 0x5b, 0xd7, 0x8a, 0x3d, 0x63, 0xc6, 0x63, 0x94, 0xfc, 0x04, 0x6e, 0x70,
+	0xe0, 0x3f, 0xed, 0x09, 0xad, 0x38, 0x21, 0x0e, 0x15, 0x6a, 0xff, 0x08, 0x9a, 0x19, 0xa7, 0x4d,
+	0x5c, 0x07, 0x69, 0x4d, 0x91, 0x10, 0xe2, 0x14, 0xcf, 0xcb, 0xf7, 0x7d, 0xef, 0xcd, 0x9b, 0x37,
+	0x9f, 0x65, 0xb8, 0x3f, 0xc5, 0xf9, 0x28, 0x0b, 0xc2, 0x19, 0x8a, 0x61, 0xc6, 0x99, 0x60, 0x84,
+	0xc4, 0x54, 0x20, 0x0f, 0xcf, 0x02, 0x1a, 0x21, 0xc5, 0x64, 0x38, 0xc5, 0xf9, 0xde, 0x6e, 0xc4,
+	0x22, 0xa6, 0xfe, 0x1e, 0xc9, 0x27, 0x8d, 0xdc, 0xdb, 0x96, 0x5c, 0xc6, 0xa7, 0xc8, 0xcb, 0xc0,
+	0xae, 0x16, 0x8b, 0xf9, 0xb7, 0xbc, 0x48, 0x30, 0xd7, 0x51, 0xef, 0x97, 0x2d, 0xb8, 0x3b, 0xc1,
+	0xf9, 0xb1, 0x4a, 0x32, 0x09, 0x44, 0x40, 0x3e, 0x00, 0x9b, 0x32, 0xf9, 0xe4, 0x1a, 0x7d, 0x63,
+	0xd0, 0x7d, 0xb4, 0x37, 0xbc, 0x99, 0x73, 0xf8, 0xb9, 0x42, 0x7c, 0xd6, 0xf2, 0x4b, 0x2c, 0x39,
+	0x86, 0x7b, 0xa7, 0xc5, 0xe2, 0x48, 0xe6, 0xd3, 0x5a, 0xae, 0xa9, 0xd8, 0x0f, 0xea, 0xd8, 0xe3,
+	0x35, 0x64, 0xa9, 0x54, 0xe1, 0x93, 0x67, 0xb0, 0x9d, 0x63, 0x92, 0xac, 0x4a, 0xb6, 0x95, 0xe4,
+	0x7b, 0x75, 0x92, 0xcf, 0xd6, 0xa1, 0xa5, 0x66, 0x55, 0x81, 0x7c, 0x09, 0xf7, 0x43, 0x8e, 0x81,
+	0xc0, 0xe3, 0x20, 0x5e, 0xaa, 0x6e, 0x29, 0xd5, 0x41, 0x9d, 0xea, 0x27, 0x15, 0x6c, 0x29, 0x7b,
+	0x43, 0x63, 0xec, 0x80, 0xad, 0xcf, 0xc9, 0x73, 0xc0, 0xd6, 0xcd, 0xf1, 0x7e, 0x36, 0x60, 0xb7,
+	0x4e, 0x80, 0xf4, 0xa1, 0x9b, 0xb3, 0x82, 0x87, 0x38, 0x41, 0xca, 0x52, 0xd5, 0xe6, 0x8e, 0xbf,
+	0x1a, 0x92, 0x08, 0x11, 0xf0, 0x08, 0x85, 0x46, 0x6c, 0x69, 0xc4, 0x4a, 0x88, 0x7c, 0x08, 0x96,
+	0x3a, 0xc6, 0xb2, 0x27, 0xef, 0xd6, 0x55, 0x2f, 0xd3, 0xfa, 0x12, 0x34, 0x36, 0x5f, 0x9c, 0xef,
+	0xb7, 0x7c, 0xcd, 0xf0, 0xde, 0x80, 0x9d, 0x6a, 0x59, 0x07, 0xe1, 0xcc, 0xfb, 0xc1, 0x82, 0x9d,
+	0x9a, 0x2e, 0xca, 0x5a, 0x82, 0x94, 0x15, 0x54, 0xac, 0x55, 0xbb, 0x12, 0x22, 0x87, 0x60, 0xeb,
+	0xa5, 0x2e, 0x74, 0x3c, 0x94, 0xd9, 0x7e, 0x3f, 0xdf, 0x7f, 0x10, 0xc5, 0xe2, 0xac, 0x38, 0x1d,
+	0x86, 0x2c, 0x1d, 0x85, 0x2c, 0x4f, 0x59, 0x5e, 0xfe, 0x3c, 0xcc, 0xa7, 0xb3, 0x91, 0x58, 0x64,
+	0x98, 0x0f, 0x9f, 0x50, 0xe1, 0x97, 0x6c, 0xd2, 0x03, 0xc8, 0x78, 0xbc, 0x6c, 0x4b, 0x5b, 0x25,
+	0x5a, 0x89, 0x90, 0x09, 0x58, 0x6a, 0xe5, 0x9a, 0xaf, 0x9c, 0x66, 0x82, 0xa1, 0xaf, 0xc9, 0xe4,
+	0x4d, 0xb0, 0xe5, 0x54, 0x20, 0x77, 0x2d, 0x95, 0xa1, 0x5c, 0x91, 0x8f, 0xa0, 0xa3, 0xae, 0xcb,
+	0xc9, 0x22, 0x43, 0xd7, 0xee, 0x1b, 0x83, 0x7b, 0xf5, 0x5d, 0x3d, 0x5a, 0x82, 0xfc, 0x6b, 0x3c,
+	0x39, 0x80, 0xae, 0x88, 0x53, 0x7c, 0x42, 0x0f, 0x19, 0x0f, 0xd1, 0xbd, 0xa3, 0xe8, 0xfb, 0x75,
+	0xf4, 0x93, 0x6b, 0x98, 0xbf, 0xca, 0x21, 0x1f, 0x83, 0x8d, 0xf3, 0x2c, 0xe6, 0x0b, 0xd7, 0x51,
+	0x47, 0xba, 0xbf, 0x31, 0xf9, 0xa7, 0x0a, 0x56, 0x1e, 0x6a, 0x49, 0x22, 0x7b, 0xe0, 0x64, 0x2c,
+	0x17, 0x47, 0x34, 0x59, 0xb8, 0x9d, 0xbe, 0x31, 0x70, 0xfc, 0xab, 0x35, 0x39, 0x81, 0xbb, 0xd3,
+	0x38, 0xcf, 0x92, 0x60, 0x71, 0xa0, 0xcf, 0x09, 0x1a, 0x9d, 0xd3, 0xba, 0x08, 0xf9, 0x1a, 0x76,
+	0x72, 0x4c, 0x9e, 0x9f, 0xf0, 0x60, 0x8a, 0xc7, 0x1c, 0xbf, 0x47, 0x2a, 0x62, 0x46, 0xdd, 0xae,
+	0xda, 0xfb, 0xa6, 0x4b, 0x5a, 0x85, 0xfb, 0x75, 0x1a, 0xde, 0x4f, 0x26, 0x90, 0xca, 0x2c, 0x1e,
+	0x84, 0x33, 0xf2, 0x15, 0x6c, 0x73, 0x4c, 0x83, 0x98, 0xc6, 0x34, 0x2a, 0x77, 0x62, 0x34, 0xda,
+	0x49, 0x55, 0x86, 0x8c, 0xc1, 0x8c, 0x82, 0x98, 0x36, 0x1c, 0x60, 0xc5, 0x95, 0xd5, 0x85, 0x01,
+	0x0d, 0xe5, 0x34, 0x4d, 0xcb, 0xea, 0xda, 0xcd, 0xaa, 0xab, 0xc8, 0x90, 0xc7, 0x60, 0x3d, 0x8f,
+	0x93, 0x24, 0x77, 0xcd, 0x7e, 0x7b, 0xd0, 0x7d, 0xf4, 0xd6, 0xc6, 0xc9, 0x58, 0x5e, 0x74, 0x85,
+	0x96, 0x93, 0x7e, 0x86, 0x71, 0x74, 0x26, 0xd4, 0xa4, 0xb7, 0xfd, 0x72, 0x45, 0xde, 0x81, 0x8e,
+	0x1c, 0xbc, 0x5c, 0x04, 0x69, 0xa6, 0x26, 0xdd, 0xf4, 0xaf, 0x03, 0xe4, 0x29, 0x38, 0x69, 0x30,
+	0x43, 0x7e, 0x88, 0x7a, 0x8e, 0x5f, 0xbd, 0xfe, 0x2b, 0xbe, 0xd4, 0x12, 0x4b, 0x2d, 0xa7, 0x99,
+	0xd6, 0x92, 0xef, 0xfd, 0x6a, 0x01, 0xb9, 0xf9, 0xe2, 0xf8, 0xcf, 0xd9, 0xd3, 0x2e, 0x58, 0xa7,
+	0xc5, 0xe2, 0xca, 0x9d, 0xf4, 0xe2, 0x7f, 0x73, 0xfa, 0xf7, 0x98, 0x93, 0xbe, 0x20, 0xf3, 0x2f,
+	0x0a, 0x26, 0xd0, 0x7d, 0xad, 0xe9, 0x05, 0xd1, 0x7c, 0xef, 0xdc, 0x84, 0xd7, 0xd7, 0x87, 0xfa,
+	0x9f, 0xf5, 0xb9, 0xa7, 0xe0, 0x64, 0x85, 0xdc, 0x78, 0x8e, 0x0d, 0x6f, 0xc3, 0x15, 0x5f, 0xce,
+	0xfb, 0x77, 0xaa, 0x09, 0xcd, 0x5c, 0x4e, 0x93, 0xeb, 0x5c, 0xd3, 0xbc, 0x65, 0xd7, 0xb4, 0x1a,
+	0xba, 0xa6, 0xbd, 0xd9, 0x35, 0xef, 0xfc, 0x95, 0x6b, 0x3a, 0xb7, 0xe8, 0x9a, 0x9d, 0xbf, 0xe7,
+	0x9a, 0xe3, 0xc7, 0x2f, 0x2e, 0x7a, 0xc6, 0xcb, 0x8b, 0x9e, 0xf1, 0xc7, 0x45, 0xcf, 0xf8, 0xf1,
+	0xb2, 0xd7, 0x7a, 0x79, 0xd9, 0x6b, 0xfd, 0x76, 0xd9, 0x6b, 0x7d, 0xf3, 0xf6, 0x4a, 0x3b, 0x1e,
+	0x52, 0x4c, 0x46, 0xf3, 0x91, 0xfc, 0x42, 0x50, 0x22, 0xa7, 0xb6, 0xfa, 0x3a, 0x78, 0xff, 0xcf,
+	0x01, 0x00, 0xa8, 0x8a, 0xd4, 0x4c, 0x82, 0x0c, 0x00, 0x00,
 }
 
 func (m *DexPacketData) Marshal() (dAtA []byte, err error) {
@@ -723,6 +852,16 @@ func (m *CreatePairPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.TargetDenom) > 0 {
 		i -= len(m.TargetDenom)
 		copy(dAtA[i:], m.TargetDenom)
@@ -783,6 +922,51 @@ func (m *SellOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DisplayAmount.Size()
+		i -= size
+		if _, err := m.DisplayAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.TimeInForce != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Seller) > 0 {
 		i -= len(m.Seller)
 		copy(dAtA[i:], m.Seller)
@@ -790,11 +974,16 @@ func (m *SellOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Price != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x20
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.PriceDenom) > 0 {
 		i -= len(m.PriceDenom)
 		copy(dAtA[i:], m.PriceDenom)
@@ -802,11 +991,16 @@ func (m *SellOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Amount != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.AmountDenom) > 0 {
 		i -= len(m.AmountDenom)
 		copy(dAtA[i:], m.AmountDenom)
@@ -837,16 +1031,80 @@ func (m *SellOrderPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Gain != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Gain))
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MakerFee.Size()
+		i -= size
+		if _, err := m.MakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Timestamp != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x30
 	}
-	if m.RemainingAmount != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.RemainingAmount))
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x28
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.CancelledAmount.Size()
+		i -= size
+		if _, err := m.CancelledAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Gain.Size()
+		i -= size
+		if _, err := m.Gain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -870,6 +1128,61 @@ func (m *BuyOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxQuote.Size()
+		i -= size
+		if _, err := m.MaxQuote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DisplayAmount.Size()
+		i -= size
+		if _, err := m.DisplayAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.TimeInForce != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Buyer) > 0 {
 		i -= len(m.Buyer)
 		copy(dAtA[i:], m.Buyer)
@@ -877,11 +1190,16 @@ func (m *BuyOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Price != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x20
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.PriceDenom) > 0 {
 		i -= len(m.PriceDenom)
 		copy(dAtA[i:], m.PriceDenom)
@@ -889,11 +1207,16 @@ func (m *BuyOrderPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Amount != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.AmountDenom) > 0 {
 		i -= len(m.AmountDenom)
 		copy(dAtA[i:], m.AmountDenom)
@@ -924,16 +1247,90 @@ func (m *BuyOrderPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Purchase != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.Purchase))
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MakerFee.Size()
+		i -= size
+		if _, err := m.MakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Timestamp != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x38
 	}
-	if m.RemainingAmount != 0 {
-		i = encodeVarintPacket(dAtA, i, uint64(m.RemainingAmount))
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x30
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.CancelledAmount.Size()
+		i -= size
+		if _, err := m.CancelledAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Quote.Size()
+		i -= size
+		if _, err := m.Quote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Purchase.Size()
+		i -= size
+		if _, err := m.Purchase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1031,6 +1428,8 @@ func (m *CreatePairPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = m.Rules.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	return n
 }
 
@@ -1053,20 +1452,34 @@ func (m *SellOrderPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovPacket(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	l = len(m.PriceDenom)
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovPacket(uint64(m.Price))
-	}
+	l = m.Price.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	l = len(m.Seller)
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.OrderType != 0 {
+		n += 1 + sovPacket(uint64(m.OrderType))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovPacket(uint64(m.TimeInForce))
+	}
+	l = m.Expiry.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.PostOnly {
+		n += 2
+	}
+	l = m.DisplayAmount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovPacket(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -1076,12 +1489,28 @@ func (m *SellOrderPacketAck) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.RemainingAmount != 0 {
-		n += 1 + sovPacket(uint64(m.RemainingAmount))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	l = m.Gain.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	l = m.CancelledAmount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
 	}
-	if m.Gain != 0 {
-		n += 1 + sovPacket(uint64(m.Gain))
+	if m.Timestamp != 0 {
+		n += 1 + sovPacket(uint64(m.Timestamp))
 	}
+	l = m.MakerFee.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	return n
 }
 
@@ -1095,20 +1524,36 @@ func (m *BuyOrderPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovPacket(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	l = len(m.PriceDenom)
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovPacket(uint64(m.Price))
-	}
+	l = m.Price.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	l = len(m.Buyer)
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.OrderType != 0 {
+		n += 1 + sovPacket(uint64(m.OrderType))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovPacket(uint64(m.TimeInForce))
+	}
+	l = m.Expiry.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.PostOnly {
+		n += 2
+	}
+	l = m.DisplayAmount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovPacket(uint64(m.SelfTradePrevention))
+	}
+	l = m.MaxQuote.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	return n
 }
 
@@ -1118,12 +1563,30 @@ func (m *BuyOrderPacketAck) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.RemainingAmount != 0 {
-		n += 1 + sovPacket(uint64(m.RemainingAmount))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	l = m.Purchase.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	l = m.Quote.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	l = m.CancelledAmount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
 	}
-	if m.Purchase != 0 {
-		n += 1 + sovPacket(uint64(m.Purchase))
+	if m.Timestamp != 0 {
+		n += 1 + sovPacket(uint64(m.Timestamp))
 	}
+	l = m.MakerFee.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovPacket(uint64(l))
 	return n
 }
 
@@ -1466,6 +1929,39 @@ func (m *CreatePairPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1599,10 +2095,10 @@ func (m *SellOrderPacketData) Unmarshal(dAtA []byte) error {
 			m.AmountDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1612,11 +2108,26 @@ func (m *SellOrderPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
@@ -1650,10 +2161,10 @@ func (m *SellOrderPacketData) Unmarshal(dAtA []byte) error {
 			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Price = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1663,11 +2174,26 @@ func (m *SellOrderPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
@@ -1700,61 +2226,82 @@ func (m *SellOrderPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPacket(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SellOrderPacketAck) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPacket
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SellOrderPacketAck: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SellOrderPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
 			}
-			m.RemainingAmount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1764,16 +2311,51 @@ func (m *SellOrderPacketAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RemainingAmount |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.PostOnly = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
 			}
-			m.Gain = 0
+			m.SelfTradePrevention = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1783,7 +2365,7 @@ func (m *SellOrderPacketAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gain |= int32(b&0x7F) << shift
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1809,7 +2391,7 @@ func (m *SellOrderPacketAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
+func (m *SellOrderPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1832,15 +2414,15 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuyOrderPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: SellOrderPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuyOrderPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SellOrderPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1868,13 +2450,15 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1884,14 +2468,29 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1919,13 +2518,15 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.CancelledAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
-			m.Price = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1935,14 +2536,67 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, Order{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1970,7 +2624,43 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1993,7 +2683,7 @@ func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuyOrderPacketAck) Unmarshal(dAtA []byte) error {
+func (m *BuyOrderPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2016,17 +2706,17 @@ func (m *BuyOrderPacketAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuyOrderPacketAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuyOrderPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuyOrderPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuyOrderPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
 			}
-			m.RemainingAmount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -2036,16 +2726,29 @@ func (m *BuyOrderPacketAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RemainingAmount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Purchase = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -2055,11 +2758,628 @@ func (m *BuyOrderPacketAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Purchase |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnly = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrderPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyOrderPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyOrderPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Purchase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CancelledAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, Order{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1,13 +1,66 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // ValidateBasic is used for validating the packet
 func (p BuyOrderPacketData) ValidateBasic() error {
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return ErrZeroAmount
+	}
+
+	// market orders may omit their protection price, their maximum quote bounding the escrowed quote
+	if p.Price.IsNil() || p.Price.IsNegative() || (p.Price.IsZero() && p.OrderType != MarketOrder) {
+		return ErrZeroPrice
+	}
+
+	if err := ValidateMaxQuote(p.Price, p.MaxQuote); err != nil {
+		return err
+	}
+
+	if p.Price.IsPositive() {
+		if err := ValidateQuote(p.Amount, p.Price); err != nil {
+			return err
+		}
+	}
+
+	if err := p.OrderType.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.TimeInForce.Validate(); err != nil {
+		return err
+	}
 
-	// TODO: Validate the packet data
+	if err := p.Expiry.Validate(p.OrderType, p.TimeInForce); err != nil {
+		return err
+	}
+
+	if err := ValidatePostOnly(p.PostOnly, p.OrderType, p.TimeInForce); err != nil {
+		return err
+	}
+
+	if err := ValidateDisplayAmount(p.DisplayAmount, p.OrderType, p.TimeInForce); err != nil {
+		return err
+	}
+
+	if err := p.SelfTradePrevention.Validate(); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// ValidateRules checks the packet against the trading rules of the pair on the receiving chain, which are
+// stored with its order book and cannot be checked by ValidateBasic
+func (p BuyOrderPacketData) ValidateRules(rules PairRules) error {
+	return rules.ValidateOrderType(p.OrderType, p.Amount, p.Price)
+}
+
+// ValidateLimits checks the order against the maximum amount and price of the params of the module
+func (p BuyOrderPacketData) ValidateLimits(params Params) error {
+	return params.ValidateOrderType(p.OrderType, p.Amount, p.Price)
+}
+
 // GetBytes is a helper for serialising
 func (p BuyOrderPacketData) GetBytes() ([]byte, error) {
 	var modulePacket DexPacketData
@@ -16,3 +69,33 @@ func (p BuyOrderPacketData) GetBytes() ([]byte, error) {
 
 	return modulePacket.Marshal()
 }
+
+// EscrowAmount returns the quote escrowed for the order on the source chain
+func (p BuyOrderPacketData) EscrowAmount() sdk.Int {
+	return buyEscrowAmount(p.Amount, p.Price, p.MaxQuote)
+}
+
+// HasProtectionPrice returns whether the order is bounded by a price, market orders without protection
+// price being bounded by their maximum quote instead
+func (p BuyOrderPacketData) HasProtectionPrice() bool {
+	return p.Price.IsPositive()
+}
+
+// PriceImprovement returns the part of the escrowed quote that was neither spent on the counterparty
+// chain nor kept in escrow for the remaining amount appended to the local buy order book
+func (p BuyOrderPacketData) PriceImprovement(ack BuyOrderPacketAck) sdk.Int {
+	if ack.Quote.IsNil() || ack.RemainingAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+
+	escrowed := p.EscrowAmount()
+	reserved := QuoteAmount(ack.RemainingAmount, p.Price)
+
+	return escrowed.Sub(ack.Quote).Sub(reserved)
+}
+
+// RestsOnBook returns whether the unfilled amount of the order is appended to the order book of the
+// source chain, otherwise it is refunded
+func (p BuyOrderPacketData) RestsOnBook() bool {
+	return RestsOnBook(p.OrderType, p.TimeInForce)
+}
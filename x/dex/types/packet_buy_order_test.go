@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestBuyOrderPacketData_PriceImprovement(t *testing.T) {
+	data := types.BuyOrderPacketData{
+		AmountDenom: "foo",
+		Amount:      sdk.NewInt(100),
+		PriceDenom:  "bar",
+		Price:       sdk.NewDec(30),
+	}
+
+	// filled against asks of 30@20 and 20@25, the remaining 50 is appended to the buy book
+	ack := types.BuyOrderPacketAck{
+		RemainingAmount: sdk.NewInt(50),
+		Purchase:        sdk.NewInt(30 + 20),
+		Quote:           sdk.NewInt(30*20 + 20*25),
+	}
+
+	// 100*30 escrowed, 30*20 + 20*25 spent, 50*30 kept for the remaining order
+	require.True(t, sdk.NewInt(100*30-30*20-20*25-50*30).Equal(data.PriceImprovement(ack)))
+
+	// no improvement when nothing is filled
+	ack = types.BuyOrderPacketAck{
+		RemainingAmount: data.Amount,
+		Purchase:        sdk.ZeroInt(),
+		Quote:           sdk.ZeroInt(),
+	}
+	require.True(t, data.PriceImprovement(ack).IsZero())
+
+	// acknowledgments without a quote are not refunded
+	ack = types.BuyOrderPacketAck{
+		RemainingAmount: sdk.ZeroInt(),
+		Purchase:        data.Amount,
+	}
+	require.True(t, data.PriceImprovement(ack).IsZero())
+
+	// market orders without protection price escrow their maximum quote, the remaining amount is refunded
+	// with the improvement
+	data.Price = sdk.ZeroDec()
+	data.OrderType = types.MarketOrder
+	data.MaxQuote = sdk.NewInt(1500)
+	ack = types.BuyOrderPacketAck{
+		RemainingAmount: sdk.NewInt(50),
+		Purchase:        sdk.NewInt(30 + 20),
+		Quote:           sdk.NewInt(30*20 + 20*25),
+	}
+	require.True(t, sdk.NewInt(1500-30*20-20*25).Equal(data.PriceImprovement(ack)))
+	require.True(t, sdk.NewInt(1500).Equal(data.EscrowAmount()))
+}
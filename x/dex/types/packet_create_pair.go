@@ -2,10 +2,7 @@ package types
 
 // ValidateBasic is used for validating the packet
 func (p CreatePairPacketData) ValidateBasic() error {
-
-	// TODO: Validate the packet data
-
-	return nil
+	return p.Rules.Validate()
 }
 
 // GetBytes is a helper for serialising
@@ -2,12 +2,53 @@ package types
 
 // ValidateBasic is used for validating the packet
 func (p SellOrderPacketData) ValidateBasic() error {
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return ErrZeroAmount
+	}
 
-	// TODO: Validate the packet data
+	if err := p.OrderType.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.TimeInForce.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.Expiry.Validate(p.OrderType, p.TimeInForce); err != nil {
+		return err
+	}
+
+	if err := ValidatePostOnly(p.PostOnly, p.OrderType, p.TimeInForce); err != nil {
+		return err
+	}
+
+	if err := ValidateDisplayAmount(p.DisplayAmount, p.OrderType, p.TimeInForce); err != nil {
+		return err
+	}
+
+	if err := p.SelfTradePrevention.Validate(); err != nil {
+		return err
+	}
+
+	// market sell orders without protection price sweep the whole book
+	if p.Price.IsNil() || p.Price.IsNegative() || (p.Price.IsZero() && p.OrderType != MarketOrder) {
+		return ErrZeroPrice
+	}
 
 	return nil
 }
 
+// ValidateRules checks the packet against the trading rules of the pair on the receiving chain, which are
+// stored with its order book and cannot be checked by ValidateBasic
+func (p SellOrderPacketData) ValidateRules(rules PairRules) error {
+	return rules.ValidateOrderType(p.OrderType, p.Amount, p.Price)
+}
+
+// ValidateLimits checks the order against the maximum amount and price of the params of the module
+func (p SellOrderPacketData) ValidateLimits(params Params) error {
+	return params.ValidateOrderType(p.OrderType, p.Amount, p.Price)
+}
+
 // GetBytes is a helper for serialising
 func (p SellOrderPacketData) GetBytes() ([]byte, error) {
 	var modulePacket DexPacketData
@@ -16,3 +57,9 @@ func (p SellOrderPacketData) GetBytes() ([]byte, error) {
 
 	return modulePacket.Marshal()
 }
+
+// RestsOnBook returns whether the unfilled amount of the order is appended to the order book of the
+// source chain, otherwise it is refunded
+func (p SellOrderPacketData) RestsOnBook() bool {
+	return RestsOnBook(p.OrderType, p.TimeInForce)
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ErrInvalidPairRules = errors.New("invalid pair rules")
+	ErrTickSize         = errors.New("price is not a multiple of the tick size of the pair")
+	ErrLotSize          = errors.New("amount is not a multiple of the lot size of the pair")
+	ErrMinAmount        = errors.New("amount is below the minimum order size of the pair")
+	ErrMaxOrderAmount   = errors.New("amount is above the maximum order size of the pair")
+	ErrMinNotional      = errors.New("order value is below the minimum notional of the pair")
+	ErrPairHalted       = errors.New("trading is halted on the pair")
+)
+
+func NewPairRules(
+	tickSize sdk.Dec,
+	lotSize sdk.Int,
+	minAmount sdk.Int,
+	maxAmount sdk.Int,
+	minNotional sdk.Int,
+) PairRules {
+	return PairRules{
+		TickSize:    tickSize,
+		LotSize:     lotSize,
+		MinAmount:   minAmount,
+		MaxAmount:   maxAmount,
+		MinNotional: minNotional,
+		// price-time priority does not use the minimum allocation of pro-rata matching
+		MinAllocation: sdk.ZeroInt(),
+	}
+}
+
+// Validate checks that the rules are not negative, that the order size range is not empty, that the
+// matching mode and algorithm are known and that the fee rates overridden are valid
+func (r PairRules) Validate() error {
+	for _, i := range []sdk.Int{r.LotSize, r.MinAmount, r.MaxAmount, r.MinNotional, r.MinAllocation} {
+		if !i.IsNil() && i.IsNegative() {
+			return ErrInvalidPairRules
+		}
+	}
+	if !r.TickSize.IsNil() && r.TickSize.IsNegative() {
+		return ErrInvalidPairRules
+	}
+
+	if isRuleSet(r.MinAmount) && isRuleSet(r.MaxAmount) && r.MinAmount.GT(r.MaxAmount) {
+		return ErrInvalidPairRules
+	}
+
+	if _, ok := MatchingMode_name[int32(r.MatchingMode)]; !ok {
+		return ErrInvalidPairRules
+	}
+
+	if _, ok := MatchingAlgorithm_name[int32(r.MatchingAlgorithm)]; !ok {
+		return ErrInvalidPairRules
+	}
+
+	for _, rate := range []*sdk.Dec{r.MakerFeeRate, r.TakerFeeRate} {
+		if rate != nil && ValidateFeeRate(*rate) != nil {
+			return ErrInvalidPairRules
+		}
+	}
+
+	return nil
+}
+
+// ValidateAmount checks the amount of an order against the lot size and the order size range
+func (r PairRules) ValidateAmount(amount sdk.Int) error {
+	if isRuleSet(r.LotSize) && !amount.Mod(r.LotSize).IsZero() {
+		return ErrLotSize
+	}
+
+	if isRuleSet(r.MinAmount) && amount.LT(r.MinAmount) {
+		return ErrMinAmount
+	}
+
+	if isRuleSet(r.MaxAmount) && amount.GT(r.MaxAmount) {
+		return ErrMaxOrderAmount
+	}
+
+	return nil
+}
+
+// ValidatePrice checks the price of an order against the tick size
+func (r PairRules) ValidatePrice(price sdk.Dec) error {
+	if r.TickSize.IsNil() || !r.TickSize.IsPositive() {
+		return nil
+	}
+
+	// decimals share the same precision, so their integer representations can be divided
+	if !sdk.NewIntFromBigInt(price.BigInt()).Mod(sdk.NewIntFromBigInt(r.TickSize.BigInt())).IsZero() {
+		return ErrTickSize
+	}
+
+	return nil
+}
+
+// ValidateOrder checks the amount and the price of an order against the rules
+func (r PairRules) ValidateOrder(amount sdk.Int, price sdk.Dec) error {
+	if err := r.ValidateAmount(amount); err != nil {
+		return err
+	}
+
+	if err := r.ValidatePrice(price); err != nil {
+		return err
+	}
+
+	if isRuleSet(r.MinNotional) && QuoteAmount(amount, price).LT(r.MinNotional) {
+		return ErrMinNotional
+	}
+
+	return nil
+}
+
+// ValidateOrderType checks an order against the rules, the price of market orders being only a
+// protection price
+func (r PairRules) ValidateOrderType(orderType OrderType, amount sdk.Int, price sdk.Dec) error {
+	if orderType == MarketOrder {
+		return r.ValidateAmount(amount)
+	}
+
+	return r.ValidateOrder(amount, price)
+}
+
+func isRuleSet(rule sdk.Int) bool {
+	return !rule.IsNil() && rule.IsPositive()
+}
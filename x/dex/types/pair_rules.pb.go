@@ -0,0 +1,816 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/pair_rules.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MatchingMode defines when the orders received for a pair are matched
+type MatchingMode int32
+
+const (
+	// continuous matching fills the orders as soon as they are received
+	ContinuousMatching MatchingMode = 0
+	// batch auctions queue the orders received in a block and fill the crossing ones at a single clearing
+	// price at the end of the block
+	BatchAuction MatchingMode = 1
+)
+
+var MatchingMode_name = map[int32]string{
+	0: "MATCHING_MODE_CONTINUOUS",
+	1: "MATCHING_MODE_BATCH_AUCTION",
+}
+
+var MatchingMode_value = map[string]int32{
+	"MATCHING_MODE_CONTINUOUS":    0,
+	"MATCHING_MODE_BATCH_AUCTION": 1,
+}
+
+func (x MatchingMode) String() string {
+	return proto.EnumName(MatchingMode_name, int32(x))
+}
+
+func (MatchingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d672b4ede754382, []int{0}
+}
+
+// MatchingAlgorithm defines how the amount of an incoming order is allocated among the resting orders
+type MatchingAlgorithm int32
+
+const (
+	// price-time priority fills the resting orders one at a time, best price then oldest first
+	PriceTimePriority MatchingAlgorithm = 0
+	// pro-rata matching shares the amount among the orders of the best price level in proportion to their
+	// amounts
+	ProRata MatchingAlgorithm = 1
+)
+
+var MatchingAlgorithm_name = map[int32]string{
+	0: "MATCHING_ALGORITHM_PRICE_TIME",
+	1: "MATCHING_ALGORITHM_PRO_RATA",
+}
+
+var MatchingAlgorithm_value = map[string]int32{
+	"MATCHING_ALGORITHM_PRICE_TIME": 0,
+	"MATCHING_ALGORITHM_PRO_RATA":   1,
+}
+
+func (x MatchingAlgorithm) String() string {
+	return proto.EnumName(MatchingAlgorithm_name, int32(x))
+}
+
+func (MatchingAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d672b4ede754382, []int{1}
+}
+
+// PairRules are the trading rules of a pair, a zero value disabling the rule
+type PairRules struct {
+	// tickSize is the increment of the order prices
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tickSize"`
+	// lotSize is the increment of the order amounts
+	LotSize   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lotSize"`
+	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minAmount"`
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxAmount"`
+	// minNotional is the minimum amount of price denom of an order at its price
+	MinNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=minNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minNotional"`
+	// matchingMode defines whether orders are filled as they are received or in batch auctions
+	MatchingMode MatchingMode `protobuf:"varint,6,opt,name=matchingMode,proto3,enum=interchangenel.dex.MatchingMode" json:"matchingMode,omitempty"`
+	// matchingAlgorithm defines how the amount of an incoming order is allocated among the resting orders
+	MatchingAlgorithm MatchingAlgorithm `protobuf:"varint,7,opt,name=matchingAlgorithm,proto3,enum=interchangenel.dex.MatchingAlgorithm" json:"matchingAlgorithm,omitempty"`
+	// minAllocation is the minimum amount allocated to a resting order by pro-rata matching
+	MinAllocation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=minAllocation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minAllocation"`
+	// makerFeeRate and takerFeeRate override the fee rates of the module params for the pair when set
+	MakerFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"makerFeeRate,omitempty"`
+	TakerFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"takerFeeRate,omitempty"`
+}
+
+func (m *PairRules) Reset()         { *m = PairRules{} }
+func (m *PairRules) String() string { return proto.CompactTextString(m) }
+func (*PairRules) ProtoMessage()    {}
+func (*PairRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d672b4ede754382, []int{0}
+}
+func (m *PairRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairRules.Merge(m, src)
+}
+func (m *PairRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairRules proto.InternalMessageInfo
+
+func (m *PairRules) GetMatchingMode() MatchingMode {
+	if m != nil {
+		return m.MatchingMode
+	}
+	return ContinuousMatching
+}
+
+func (m *PairRules) GetMatchingAlgorithm() MatchingAlgorithm {
+	if m != nil {
+		return m.MatchingAlgorithm
+	}
+	return PriceTimePriority
+}
+
+func init() {
+	proto.RegisterEnum("interchangenel.dex.MatchingMode", MatchingMode_name, MatchingMode_value)
+	proto.RegisterEnum("interchangenel.dex.MatchingAlgorithm", MatchingAlgorithm_name, MatchingAlgorithm_value)
+	proto.RegisterType((*PairRules)(nil), "interchangenel.dex.PairRules")
+}
+
+func init() { proto.RegisterFile("dex/pair_rules.proto", fileDescriptor_5d672b4ede754382) }
+
+var fileDescriptor_5d672b4ede754382 = []byte{
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x6b, 0xdb, 0x3c,
+	0x00, 0xc7, 0xed, 0xe7, 0xe9, 0xda, 0x46, 0xed, 0x46, 0x2a, 0xba, 0x61, 0x5c, 0xe6, 0x9a, 0xc1,
+	0x46, 0x09, 0xab, 0xcd, 0xde, 0x60, 0x57, 0xe7, 0x65, 0x8d, 0x47, 0xfd, 0x82, 0xe3, 0x5c, 0x76,
+	0x31, 0xae, 0x23, 0x1c, 0x11, 0x5b, 0x0a, 0xb6, 0x0c, 0xe9, 0x60, 0x87, 0xdd, 0x46, 0x4e, 0xfb,
+	0x02, 0x81, 0xc1, 0xbe, 0x4c, 0x8f, 0x3d, 0x8e, 0x1d, 0xca, 0x48, 0xbe, 0xc8, 0xb0, 0xdb, 0x64,
+	0x0e, 0x19, 0x83, 0xe4, 0x64, 0x0b, 0xfd, 0x7f, 0x3f, 0xa4, 0xbf, 0x84, 0xc0, 0x61, 0x0f, 0x8d,
+	0xd4, 0xa1, 0x8f, 0x13, 0x2f, 0xc9, 0x22, 0x94, 0x2a, 0xc3, 0x84, 0x32, 0x0a, 0x21, 0x26, 0x0c,
+	0x25, 0x41, 0xdf, 0x27, 0x21, 0x22, 0x28, 0x52, 0x7a, 0x68, 0x24, 0x1e, 0x86, 0x34, 0xa4, 0xc5,
+	0xb4, 0x9a, 0xff, 0xdd, 0x26, 0x9f, 0x7c, 0xdb, 0x06, 0x15, 0xdb, 0xc7, 0x89, 0x93, 0xd3, 0xf0,
+	0x3d, 0xd8, 0x65, 0x38, 0x18, 0x74, 0xf0, 0x47, 0x24, 0xf0, 0x32, 0x7f, 0x52, 0xa9, 0x2b, 0x57,
+	0x37, 0xc7, 0xdc, 0xcf, 0x9b, 0xe3, 0x67, 0x21, 0x66, 0xfd, 0xec, 0x42, 0x09, 0x68, 0xac, 0x06,
+	0x34, 0x8d, 0x69, 0x7a, 0xf7, 0x39, 0x4d, 0x7b, 0x03, 0x95, 0x5d, 0x0e, 0x51, 0xaa, 0x34, 0x51,
+	0xe0, 0x2c, 0x78, 0xd8, 0x06, 0x3b, 0x11, 0x65, 0x85, 0xea, 0xbf, 0xb5, 0x55, 0x3a, 0x61, 0xce,
+	0x1c, 0x87, 0xe7, 0xa0, 0x12, 0x63, 0xa2, 0xc5, 0x34, 0x23, 0x4c, 0xf8, 0x7f, 0x23, 0xd7, 0x1f,
+	0x41, 0x61, 0xf3, 0x47, 0x77, 0xb6, 0xad, 0x0d, 0x6d, 0x73, 0x01, 0xb4, 0xc1, 0x5e, 0x8c, 0x89,
+	0x49, 0x19, 0xa6, 0xc4, 0x8f, 0x84, 0x7b, 0x1b, 0xf9, 0xca, 0x0a, 0xd8, 0x04, 0xfb, 0xb1, 0xcf,
+	0x82, 0x3e, 0x26, 0xa1, 0x41, 0x7b, 0x48, 0xd8, 0x96, 0xf9, 0x93, 0x07, 0x2f, 0x65, 0x65, 0xf5,
+	0x48, 0x15, 0xa3, 0x94, 0x73, 0x96, 0x28, 0xd8, 0x01, 0x07, 0xf3, 0xb1, 0x16, 0x85, 0x34, 0xc1,
+	0xac, 0x1f, 0x0b, 0x3b, 0x85, 0xea, 0xe9, 0xbf, 0x54, 0x8b, 0xb0, 0xb3, 0xca, 0x43, 0x17, 0xdc,
+	0xcf, 0x7b, 0x8c, 0x22, 0x1a, 0xf8, 0xf9, 0x6a, 0x85, 0xdd, 0x8d, 0xb6, 0xbb, 0x2c, 0x81, 0x66,
+	0xbe, 0xe1, 0x01, 0x4a, 0xde, 0x21, 0xe4, 0xf8, 0x0c, 0x09, 0x95, 0x42, 0x5a, 0x5b, 0xe3, 0xd2,
+	0x2d, 0xf1, 0xb9, 0x8f, 0x95, 0x7d, 0x60, 0x7d, 0x5f, 0x99, 0xaf, 0x7d, 0x02, 0xfb, 0xe5, 0xa2,
+	0xe1, 0x6b, 0x20, 0x18, 0x9a, 0xdb, 0x68, 0xeb, 0xe6, 0x99, 0x67, 0x58, 0xcd, 0x96, 0xd7, 0xb0,
+	0x4c, 0x57, 0x37, 0xbb, 0x56, 0xb7, 0x53, 0xe5, 0xc4, 0x47, 0xe3, 0x89, 0x0c, 0x1b, 0x94, 0x30,
+	0x4c, 0x32, 0x9a, 0xa5, 0x73, 0x12, 0xbe, 0x00, 0x47, 0xcb, 0x54, 0x3d, 0x1f, 0x79, 0x5a, 0xb7,
+	0xe1, 0xea, 0x96, 0x59, 0xe5, 0xc5, 0xea, 0x78, 0x22, 0xef, 0xd7, 0xf3, 0xb8, 0x96, 0x05, 0x79,
+	0x31, 0xe2, 0xd6, 0x97, 0xef, 0x12, 0x57, 0xfb, 0xcc, 0x83, 0x83, 0x95, 0xd3, 0x81, 0x6f, 0xc1,
+	0xe3, 0x85, 0x4e, 0x3b, 0x3f, 0xb3, 0x1c, 0xdd, 0x6d, 0x1b, 0x9e, 0xed, 0xe8, 0x8d, 0x96, 0xe7,
+	0xea, 0x46, 0xab, 0xca, 0x89, 0x0f, 0xc7, 0x13, 0xf9, 0xc0, 0x4e, 0x70, 0x80, 0x5c, 0x1c, 0x23,
+	0x3b, 0xc1, 0x39, 0x7a, 0x09, 0x9f, 0x83, 0xa3, 0xbf, 0x92, 0x96, 0xe7, 0x68, 0xae, 0x56, 0xe5,
+	0xc5, 0xbd, 0xf1, 0x44, 0xde, 0xb1, 0x13, 0xea, 0xf8, 0xcc, 0xbf, 0x5d, 0x43, 0xfd, 0xcd, 0xd5,
+	0x54, 0xe2, 0xaf, 0xa7, 0x12, 0xff, 0x6b, 0x2a, 0xf1, 0x5f, 0x67, 0x12, 0x77, 0x3d, 0x93, 0xb8,
+	0x1f, 0x33, 0x89, 0xfb, 0x70, 0x54, 0xba, 0x4b, 0xa7, 0x04, 0x45, 0xea, 0x48, 0xcd, 0x5f, 0xa4,
+	0xa2, 0xcb, 0x8b, 0xed, 0xe2, 0x8d, 0x79, 0xf5, 0x7b, 0x00, 0xa4, 0xcf, 0xdb, 0xd8, 0xa5, 0x04,
+	0x00, 0x00,
+}
+
+func (m *PairRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeRate != nil {
+		{
+			size := m.TakerFeeRate.Size()
+			i -= size
+			if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPairRules(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MakerFeeRate != nil {
+		{
+			size := m.MakerFeeRate.Size()
+			i -= size
+			if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPairRules(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.MinAllocation.Size()
+		i -= size
+		if _, err := m.MinAllocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPairRules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MatchingAlgorithm != 0 {
+		i = encodeVarintPairRules(dAtA, i, uint64(m.MatchingAlgorithm))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MatchingMode != 0 {
+		i = encodeVarintPairRules(dAtA, i, uint64(m.MatchingMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinNotional.Size()
+		i -= size
+		if _, err := m.MinNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPairRules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPairRules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPairRules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPairRules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPairRules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPairRules(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPairRules(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PairRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TickSize.Size()
+	n += 1 + l + sovPairRules(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovPairRules(uint64(l))
+	l = m.MinAmount.Size()
+	n += 1 + l + sovPairRules(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovPairRules(uint64(l))
+	l = m.MinNotional.Size()
+	n += 1 + l + sovPairRules(uint64(l))
+	if m.MatchingMode != 0 {
+		n += 1 + sovPairRules(uint64(m.MatchingMode))
+	}
+	if m.MatchingAlgorithm != 0 {
+		n += 1 + sovPairRules(uint64(m.MatchingAlgorithm))
+	}
+	l = m.MinAllocation.Size()
+	n += 1 + l + sovPairRules(uint64(l))
+	if m.MakerFeeRate != nil {
+		l = m.MakerFeeRate.Size()
+		n += 1 + l + sovPairRules(uint64(l))
+	}
+	if m.TakerFeeRate != nil {
+		l = m.TakerFeeRate.Size()
+		n += 1 + l + sovPairRules(uint64(l))
+	}
+	return n
+}
+
+func sovPairRules(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPairRules(x uint64) (n int) {
+	return sovPairRules(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PairRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPairRules
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingMode", wireType)
+			}
+			m.MatchingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingMode |= MatchingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingAlgorithm", wireType)
+			}
+			m.MatchingAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingAlgorithm |= MatchingAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MakerFeeRate = &v
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TakerFeeRate = &v
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPairRules(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPairRules
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPairRules(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPairRules
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPairRules
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPairRules
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPairRules
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPairRules
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPairRules        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPairRules          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPairRules = fmt.Errorf("proto: unexpected end of group")
+)
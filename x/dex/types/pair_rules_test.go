@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+var testPairRules = types.NewPairRules(
+	sdk.NewDecWithPrec(5, 2),
+	sdk.NewInt(10),
+	sdk.NewInt(20),
+	sdk.NewInt(1000),
+	sdk.NewInt(50),
+)
+
+func TestPairRulesValidate(t *testing.T) {
+	require.NoError(t, testPairRules.Validate())
+	require.NoError(t, types.PairRules{}.Validate())
+
+	rules := testPairRules
+	rules.TickSize = sdk.NewDec(-1)
+	require.ErrorIs(t, rules.Validate(), types.ErrInvalidPairRules)
+
+	rules = testPairRules
+	rules.MinNotional = sdk.NewInt(-1)
+	require.ErrorIs(t, rules.Validate(), types.ErrInvalidPairRules)
+
+	// the order size range cannot be empty
+	rules = testPairRules
+	rules.MinAmount = sdk.NewInt(2000)
+	require.ErrorIs(t, rules.Validate(), types.ErrInvalidPairRules)
+
+	rules = testPairRules
+	rules.MatchingMode = types.BatchAuction
+	require.NoError(t, rules.Validate())
+	rules.MatchingMode = types.MatchingMode(2)
+	require.ErrorIs(t, rules.Validate(), types.ErrInvalidPairRules)
+
+	rules = testPairRules
+	rules.MatchingAlgorithm = types.ProRata
+	rules.MinAllocation = sdk.NewInt(30)
+	require.NoError(t, rules.Validate())
+	rules.MinAllocation = sdk.NewInt(-1)
+	require.ErrorIs(t, rules.Validate(), types.ErrInvalidPairRules)
+	rules.MinAllocation = sdk.ZeroInt()
+	rules.MatchingAlgorithm = types.MatchingAlgorithm(2)
+	require.ErrorIs(t, rules.Validate(), types.ErrInvalidPairRules)
+}
+
+func TestPairRulesValidateOrder(t *testing.T) {
+	require.NoError(t, testPairRules.ValidateOrder(sdk.NewInt(100), sdk.NewDecWithPrec(105, 2)))
+	require.ErrorIs(t, testPairRules.ValidateOrder(sdk.NewInt(105), sdk.NewDec(1)), types.ErrLotSize)
+	require.ErrorIs(t, testPairRules.ValidateOrder(sdk.NewInt(10), sdk.NewDec(10)), types.ErrMinAmount)
+	require.ErrorIs(t, testPairRules.ValidateOrder(sdk.NewInt(1010), sdk.NewDec(1)), types.ErrMaxOrderAmount)
+	require.ErrorIs(t, testPairRules.ValidateOrder(sdk.NewInt(100), sdk.NewDecWithPrec(101, 2)), types.ErrTickSize)
+	require.ErrorIs(t, testPairRules.ValidateOrder(sdk.NewInt(40), sdk.NewDec(1)), types.ErrMinNotional)
+
+	// disabled rules accept any order
+	require.NoError(t, types.PairRules{}.ValidateOrder(sdk.NewInt(1), sdk.NewDecWithPrec(1, 18)))
+}
+
+func TestPairRulesValidateOrderType(t *testing.T) {
+	// the protection price of market orders is not checked
+	require.NoError(t, testPairRules.ValidateOrderType(types.MarketOrder, sdk.NewInt(20), sdk.ZeroDec()))
+	require.ErrorIs(t, testPairRules.ValidateOrderType(types.MarketOrder, sdk.NewInt(25), sdk.ZeroDec()), types.ErrLotSize)
+	require.ErrorIs(t, testPairRules.ValidateOrderType(types.LimitOrder, sdk.NewInt(20), sdk.NewDec(1)), types.ErrMinNotional)
+}
@@ -1,34 +1,152 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyTradeRetention = []byte("TradeRetention")
+	// DefaultTradeRetention keeps the trades of the last 30 days
+	DefaultTradeRetention = 30 * 24 * time.Hour
+
+	KeyCandleHorizon = []byte("CandleHorizon")
+	// DefaultCandleHorizon keeps the candles of the last 30 days
+	DefaultCandleHorizon = 30 * 24 * time.Hour
+
+	KeyMakerFeeRate = []byte("MakerFeeRate")
+	// DefaultMakerFeeRate charges no maker fee
+	DefaultMakerFeeRate = sdk.ZeroDec()
+
+	KeyTakerFeeRate = []byte("TakerFeeRate")
+	// DefaultTakerFeeRate charges no taker fee
+	DefaultTakerFeeRate = sdk.ZeroDec()
+
+	KeyFeeDistribution = []byte("FeeDistribution")
+	// DefaultFeeDistribution sends all the collected fees to the stakers
+	DefaultFeeDistribution = NewFeeDistribution(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec())
+
+	KeyMaxAmount = []byte("MaxAmount")
+	// DefaultMaxAmount limits the order amounts to 10^30
+	DefaultMaxAmount = sdk.NewIntWithDecimal(1, 30)
+
+	KeyMaxPrice = []byte("MaxPrice")
+	// DefaultMaxPrice limits the order prices to 10^30
+	DefaultMaxPrice = sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 30))
+
+	KeyTriggeredStopOrdersLimit = []byte("TriggeredStopOrdersLimit")
+	// DefaultTriggeredStopOrdersLimit triggers at most 100 stop orders per block
+	DefaultTriggeredStopOrdersLimit uint32 = 100
+
+	KeyExpiredOrdersPruneLimit = []byte("ExpiredOrdersPruneLimit")
+	// DefaultExpiredOrdersPruneLimit prunes at most 100 expired orders per block
+	DefaultExpiredOrdersPruneLimit uint32 = 100
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	tradeRetention time.Duration,
+	candleHorizon time.Duration,
+	makerFeeRate sdk.Dec,
+	takerFeeRate sdk.Dec,
+	feeDistribution FeeDistribution,
+	maxAmount sdk.Int,
+	maxPrice sdk.Dec,
+	triggeredStopOrdersLimit uint32,
+	expiredOrdersPruneLimit uint32,
+) Params {
+	return Params{
+		TradeRetention:           tradeRetention,
+		CandleHorizon:            candleHorizon,
+		MakerFeeRate:             makerFeeRate,
+		TakerFeeRate:             takerFeeRate,
+		FeeDistribution:          feeDistribution,
+		MaxAmount:                maxAmount,
+		MaxPrice:                 maxPrice,
+		TriggeredStopOrdersLimit: triggeredStopOrdersLimit,
+		ExpiredOrdersPruneLimit:  expiredOrdersPruneLimit,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultTradeRetention,
+		DefaultCandleHorizon,
+		DefaultMakerFeeRate,
+		DefaultTakerFeeRate,
+		DefaultFeeDistribution,
+		DefaultMaxAmount,
+		DefaultMaxPrice,
+		DefaultTriggeredStopOrdersLimit,
+		DefaultExpiredOrdersPruneLimit,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTradeRetention, &p.TradeRetention, validateTradeRetention),
+		paramtypes.NewParamSetPair(KeyCandleHorizon, &p.CandleHorizon, validateCandleHorizon),
+		paramtypes.NewParamSetPair(KeyMakerFeeRate, &p.MakerFeeRate, validateMakerFeeRate),
+		paramtypes.NewParamSetPair(KeyTakerFeeRate, &p.TakerFeeRate, validateTakerFeeRate),
+		paramtypes.NewParamSetPair(KeyFeeDistribution, &p.FeeDistribution, validateFeeDistribution),
+		paramtypes.NewParamSetPair(KeyMaxAmount, &p.MaxAmount, validateMaxAmount),
+		paramtypes.NewParamSetPair(KeyMaxPrice, &p.MaxPrice, validateMaxPrice),
+		paramtypes.NewParamSetPair(KeyTriggeredStopOrdersLimit, &p.TriggeredStopOrdersLimit, validateTriggeredStopOrdersLimit),
+		paramtypes.NewParamSetPair(KeyExpiredOrdersPruneLimit, &p.ExpiredOrdersPruneLimit, validateExpiredOrdersPruneLimit),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateTradeRetention(p.TradeRetention); err != nil {
+		return err
+	}
+
+	if err := validateCandleHorizon(p.CandleHorizon); err != nil {
+		return err
+	}
+
+	if err := validateMakerFeeRate(p.MakerFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateTakerFeeRate(p.TakerFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateFeeDistribution(p.FeeDistribution); err != nil {
+		return err
+	}
+
+	if err := validateMaxAmount(p.MaxAmount); err != nil {
+		return err
+	}
+
+	if err := validateMaxPrice(p.MaxPrice); err != nil {
+		return err
+	}
+
+	if err := validateTriggeredStopOrdersLimit(p.TriggeredStopOrdersLimit); err != nil {
+		return err
+	}
+
+	if err := validateExpiredOrdersPruneLimit(p.ExpiredOrdersPruneLimit); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +155,125 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateTradeRetention validates the TradeRetention param
+func validateTradeRetention(v interface{}) error {
+	tradeRetention, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if tradeRetention < 0 {
+		return fmt.Errorf("trade retention cannot be negative: %s", tradeRetention)
+	}
+
+	return nil
+}
+
+// validateCandleHorizon validates the CandleHorizon param
+func validateCandleHorizon(v interface{}) error {
+	candleHorizon, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if candleHorizon < 0 {
+		return fmt.Errorf("candle horizon cannot be negative: %s", candleHorizon)
+	}
+
+	return nil
+}
+
+// validateMakerFeeRate validates the MakerFeeRate param
+func validateMakerFeeRate(v interface{}) error {
+	makerFeeRate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := ValidateFeeRate(makerFeeRate); err != nil {
+		return fmt.Errorf("invalid maker fee rate: %w", err)
+	}
+
+	return nil
+}
+
+// validateTakerFeeRate validates the TakerFeeRate param
+func validateTakerFeeRate(v interface{}) error {
+	takerFeeRate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := ValidateFeeRate(takerFeeRate); err != nil {
+		return fmt.Errorf("invalid taker fee rate: %w", err)
+	}
+
+	return nil
+}
+
+// validateFeeDistribution validates the FeeDistribution param
+func validateFeeDistribution(v interface{}) error {
+	feeDistribution, ok := v.(FeeDistribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return feeDistribution.Validate()
+}
+
+// validateMaxAmount validates the MaxAmount param
+func validateMaxAmount(v interface{}) error {
+	maxAmount, ok := v.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxAmount.IsNil() || !maxAmount.IsPositive() {
+		return fmt.Errorf("max amount must be positive: %s", maxAmount)
+	}
+
+	return nil
+}
+
+// validateMaxPrice validates the MaxPrice param
+func validateMaxPrice(v interface{}) error {
+	maxPrice, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxPrice.IsNil() || !maxPrice.IsPositive() {
+		return fmt.Errorf("max price must be positive: %s", maxPrice)
+	}
+
+	return nil
+}
+
+// validateTriggeredStopOrdersLimit validates the TriggeredStopOrdersLimit param
+func validateTriggeredStopOrdersLimit(v interface{}) error {
+	triggeredStopOrdersLimit, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if triggeredStopOrdersLimit == 0 {
+		return fmt.Errorf("triggered stop orders limit must be positive")
+	}
+
+	return nil
+}
+
+// validateExpiredOrdersPruneLimit validates the ExpiredOrdersPruneLimit param
+func validateExpiredOrdersPruneLimit(v interface{}) error {
+	expiredOrdersPruneLimit, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if expiredOrdersPruneLimit == 0 {
+		return fmt.Errorf("expired orders prune limit must be positive")
+	}
+
+	return nil
+}
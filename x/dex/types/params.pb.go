@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// tradeRetention is how long trades are kept in the trade history, forever if zero
+	TradeRetention time.Duration `protobuf:"bytes,1,opt,name=tradeRetention,proto3,stdduration" json:"tradeRetention"`
+	// candleHorizon is how long candles are kept after their interval ends, forever if zero
+	CandleHorizon time.Duration `protobuf:"bytes,2,opt,name=candleHorizon,proto3,stdduration" json:"candleHorizon"`
+	// makerFeeRate is the share of their proceeds paid by the resting orders when filled
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"makerFeeRate"`
+	// takerFeeRate is the share of their proceeds paid by the incoming orders when filled
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"takerFeeRate"`
+	// feeDistribution splits the collected fees at the end of each block
+	FeeDistribution FeeDistribution `protobuf:"bytes,5,opt,name=feeDistribution,proto3" json:"feeDistribution"`
+	// maxAmount is the maximum amount of an order
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxAmount"`
+	// maxPrice is the maximum price of an order
+	MaxPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maxPrice"`
+	// triggeredStopOrdersLimit is the maximum number of stop orders triggered in a block, the remaining
+	// ones being triggered in the next blocks
+	TriggeredStopOrdersLimit uint32 `protobuf:"varint,8,opt,name=triggeredStopOrdersLimit,proto3" json:"triggeredStopOrdersLimit,omitempty"`
+	// expiredOrdersPruneLimit is the maximum number of expired orders pruned in a block, the remaining
+	// ones being pruned in the next blocks
+	ExpiredOrdersPruneLimit uint32 `protobuf:"varint,9,opt,name=expiredOrdersPruneLimit,proto3" json:"expiredOrdersPruneLimit,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,23 +84,125 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetTradeRetention() time.Duration {
+	if m != nil {
+		return m.TradeRetention
+	}
+	return 0
+}
+
+func (m *Params) GetCandleHorizon() time.Duration {
+	if m != nil {
+		return m.CandleHorizon
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDistribution() FeeDistribution {
+	if m != nil {
+		return m.FeeDistribution
+	}
+	return FeeDistribution{}
+}
+
+func (m *Params) GetTriggeredStopOrdersLimit() uint32 {
+	if m != nil {
+		return m.TriggeredStopOrdersLimit
+	}
+	return 0
+}
+
+func (m *Params) GetExpiredOrdersPruneLimit() uint32 {
+	if m != nil {
+		return m.ExpiredOrdersPruneLimit
+	}
+	return 0
+}
+
+// FeeDistribution are the shares of the collected fees sent to the stakers, to the community pool and
+// burned, summing up to one
+type FeeDistribution struct {
+	// stakers is the share sent to the fee collector of the distribution module
+	Stakers github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=stakers,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stakers"`
+	// communityPool is the share sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"communityPool"`
+	// burn is the share burned
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn"`
+}
+
+func (m *FeeDistribution) Reset()         { *m = FeeDistribution{} }
+func (m *FeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*FeeDistribution) ProtoMessage()    {}
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e49286500ccff43e, []int{1}
+}
+func (m *FeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistribution.Merge(m, src)
+}
+func (m *FeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistribution proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "interchangenel.dex.Params")
+	proto.RegisterType((*FeeDistribution)(nil), "interchangenel.dex.FeeDistribution")
 }
 
 func init() { proto.RegisterFile("dex/params.proto", fileDescriptor_e49286500ccff43e) }
 
 var fileDescriptor_e49286500ccff43e = []byte{
-	// 139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x49, 0xad, 0xd0,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcc,
-	0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xcd, 0x4b, 0xcd, 0xd1, 0x4b, 0x49, 0xad,
-	0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a, 0x7c, 0x5c,
-	0x6c, 0x01, 0x60, 0x9d, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x99, 0x9e, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x34, 0x92, 0x99, 0xba, 0x79, 0xa9, 0x39, 0xfa, 0x15,
-	0xfa, 0x20, 0x7b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xa6, 0x19, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x46, 0xe8, 0x31, 0xd4, 0x8b, 0x00, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x6b, 0xd4, 0x40,
+	0x1c, 0xc6, 0x33, 0x75, 0xbb, 0xdd, 0x1d, 0x5d, 0x2b, 0x83, 0x60, 0xac, 0x90, 0x5d, 0x2a, 0xc8,
+	0x5e, 0x3a, 0x01, 0x45, 0x90, 0xde, 0x0c, 0x4b, 0x69, 0xb5, 0xe0, 0x92, 0x7a, 0xf2, 0x36, 0x49,
+	0xfe, 0x4d, 0x87, 0x66, 0x66, 0xc2, 0x64, 0x02, 0xa9, 0x9f, 0xc2, 0x63, 0x8f, 0x7e, 0x07, 0xbf,
+	0x44, 0x8f, 0x3d, 0x8a, 0x87, 0x2a, 0xbb, 0x9f, 0xc1, 0xbb, 0x64, 0xd2, 0xea, 0xee, 0x4a, 0x41,
+	0x73, 0xca, 0xcb, 0xf3, 0x3c, 0xbf, 0xe4, 0xff, 0x92, 0xe0, 0x07, 0x09, 0x54, 0x7e, 0xce, 0x34,
+	0x13, 0x05, 0xcd, 0xb5, 0x32, 0x8a, 0x10, 0x2e, 0x0d, 0xe8, 0xf8, 0x84, 0xc9, 0x14, 0x24, 0x64,
+	0x34, 0x81, 0x6a, 0xeb, 0x61, 0xaa, 0x52, 0x65, 0x65, 0xbf, 0x3e, 0x6b, 0x9c, 0x5b, 0x5e, 0xaa,
+	0x54, 0x9a, 0x81, 0x6f, 0xaf, 0xa2, 0xf2, 0xd8, 0x4f, 0x4a, 0xcd, 0x0c, 0x57, 0xb2, 0xd1, 0xb7,
+	0xbf, 0xac, 0xe3, 0xee, 0xd4, 0xa2, 0xc9, 0x5b, 0x7c, 0xdf, 0x68, 0x96, 0x40, 0x08, 0x06, 0x64,
+	0x6d, 0x71, 0xd1, 0x08, 0x8d, 0xef, 0x3e, 0x7f, 0x4c, 0x1b, 0x06, 0xbd, 0x61, 0xd0, 0xc9, 0x35,
+	0x23, 0xe8, 0x5d, 0x5c, 0x0d, 0x9d, 0xf3, 0xef, 0x43, 0x14, 0xae, 0x44, 0xc9, 0x01, 0x1e, 0xc4,
+	0x4c, 0x26, 0x19, 0xec, 0x2b, 0xcd, 0x3f, 0x2a, 0xe9, 0xae, 0xfd, 0x3b, 0x6b, 0x39, 0x49, 0x42,
+	0x7c, 0x4f, 0xb0, 0x53, 0xd0, 0x7b, 0x00, 0x21, 0x33, 0xe0, 0xde, 0x19, 0xa1, 0x71, 0x3f, 0xa0,
+	0xb5, 0xfd, 0xdb, 0xd5, 0xf0, 0x59, 0xca, 0xcd, 0x49, 0x19, 0xd1, 0x58, 0x09, 0x3f, 0x56, 0x85,
+	0x50, 0xc5, 0xf5, 0x61, 0xa7, 0x48, 0x4e, 0x7d, 0x73, 0x96, 0x43, 0x41, 0x27, 0x10, 0x87, 0x4b,
+	0x8c, 0x9a, 0x69, 0x16, 0x99, 0x9d, 0x76, 0xcc, 0x45, 0x06, 0x39, 0xc2, 0x9b, 0xc7, 0x00, 0x13,
+	0x5e, 0x18, 0xcd, 0xa3, 0xd2, 0x36, 0x70, 0xdd, 0x16, 0xfd, 0x94, 0xfe, 0x3d, 0x2e, 0xba, 0xb7,
+	0x6c, 0x0d, 0x3a, 0xf5, 0xb3, 0xc3, 0x55, 0x02, 0x39, 0xc4, 0x7d, 0xc1, 0xaa, 0xd7, 0x42, 0x95,
+	0xd2, 0xb8, 0xdd, 0xff, 0x7e, 0xcb, 0x03, 0x69, 0xc2, 0x3f, 0x00, 0xf2, 0x06, 0xf7, 0x04, 0xab,
+	0xa6, 0x9a, 0xc7, 0xe0, 0x6e, 0xb4, 0x2a, 0xf9, 0x77, 0x9e, 0xec, 0x62, 0xd7, 0x68, 0x9e, 0xa6,
+	0xa0, 0x21, 0x39, 0x32, 0x2a, 0x7f, 0xa7, 0x13, 0xd0, 0xc5, 0x21, 0x17, 0xdc, 0xb8, 0xbd, 0x11,
+	0x1a, 0x0f, 0xc2, 0x5b, 0x75, 0xf2, 0x0a, 0x3f, 0x82, 0x2a, 0xe7, 0x1a, 0x92, 0xe6, 0xee, 0x54,
+	0x97, 0x12, 0x9a, 0x68, 0xdf, 0x46, 0x6f, 0x93, 0x77, 0x3b, 0xe7, 0x9f, 0x87, 0xce, 0xf6, 0x4f,
+	0x84, 0x37, 0x57, 0x1a, 0x48, 0xf6, 0xf1, 0x46, 0x61, 0xe7, 0x51, 0xb8, 0xa8, 0x55, 0x69, 0x37,
+	0x71, 0xf2, 0x1e, 0x0f, 0x62, 0x25, 0x44, 0x29, 0xb9, 0x39, 0x9b, 0x2a, 0x95, 0xb9, 0x6b, 0xad,
+	0x78, 0xcb, 0x10, 0x12, 0xe0, 0x4e, 0x54, 0x6a, 0xd9, 0x72, 0x7d, 0x6d, 0x36, 0x78, 0x79, 0x31,
+	0xf3, 0xd0, 0xe5, 0xcc, 0x43, 0x3f, 0x66, 0x1e, 0xfa, 0x34, 0xf7, 0x9c, 0xcb, 0xb9, 0xe7, 0x7c,
+	0x9d, 0x7b, 0xce, 0x87, 0x27, 0x0b, 0x2b, 0xb6, 0x23, 0x21, 0xf3, 0x2b, 0xbf, 0xfe, 0x6b, 0x58,
+	0x40, 0xd4, 0xb5, 0x5f, 0xdb, 0x8b, 0x5f, 0x03, 0x00, 0xb5, 0x14, 0x74, 0xbe, 0x49, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +225,135 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiredOrdersPruneLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExpiredOrdersPruneLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TriggeredStopOrdersLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TriggeredStopOrdersLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.FeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CandleHorizon, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleHorizon):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TradeRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TradeRetention):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Stakers.Size()
+		i -= size
+		if _, err := m.Stakers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +374,41 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TradeRetention)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleHorizon)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeDistribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.TriggeredStopOrdersLimit != 0 {
+		n += 1 + sovParams(uint64(m.TriggeredStopOrdersLimit))
+	}
+	if m.ExpiredOrdersPruneLimit != 0 {
+		n += 1 + sovParams(uint64(m.ExpiredOrdersPruneLimit))
+	}
+	return n
+}
+
+func (m *FeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stakers.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -156,6 +447,431 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TradeRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleHorizon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CandleHorizon, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredStopOrdersLimit", wireType)
+			}
+			m.TriggeredStopOrdersLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggeredStopOrdersLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredOrdersPruneLimit", wireType)
+			}
+			m.ExpiredOrdersPruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredOrdersPruneLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stakers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
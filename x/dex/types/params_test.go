@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.TradeRetention = -1
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxAmount = sdk.ZeroInt()
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxPrice = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxPrice = sdk.Dec{}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TriggeredStopOrdersLimit = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ExpiredOrdersPruneLimit = 0
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,907 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/pending_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingOrderStatus is the status of an order sent to the counterparty chain and not yet acknowledged
+type PendingOrderStatus int32
+
+const (
+	// the packet of the order awaits its reception or acknowledgement
+	PendingInFlight PendingOrderStatus = 0
+	// the timeout of the packet is reached, the escrow is refunded once the timeout is relayed
+	PendingTimedOut PendingOrderStatus = 1
+)
+
+var PendingOrderStatus_name = map[int32]string{
+	0: "PENDING_ORDER_STATUS_IN_FLIGHT",
+	1: "PENDING_ORDER_STATUS_TIMED_OUT",
+}
+
+var PendingOrderStatus_value = map[string]int32{
+	"PENDING_ORDER_STATUS_IN_FLIGHT": 0,
+	"PENDING_ORDER_STATUS_TIMED_OUT": 1,
+}
+
+func (x PendingOrderStatus) String() string {
+	return proto.EnumName(PendingOrderStatus_name, int32(x))
+}
+
+func (PendingOrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a785a46dd42fff3, []int{0}
+}
+
+// PendingOrder is an order sent to the counterparty chain whose funds are escrowed on this chain until
+// its packet is acknowledged or times out
+type PendingOrder struct {
+	Port        string                                 `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel     string                                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence    uint64                                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Creator     string                                 `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Side        uint32                                 `protobuf:"varint,5,opt,name=side,proto3" json:"side,omitempty"`
+	AmountDenom string                                 `protobuf:"bytes,6,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	PriceDenom  string                                 `protobuf:"bytes,8,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// escrowDenom and escrowAmount are the funds escrowed for the order, the amount sold or the quote of
+	// the amount bought
+	EscrowDenom  string                                 `protobuf:"bytes,10,opt,name=escrowDenom,proto3" json:"escrowDenom,omitempty"`
+	EscrowAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=escrowAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrowAmount"`
+	// timeoutTimestamp is the timeout of the packet in unix nanoseconds, zero for none
+	TimeoutTimestamp uint64             `protobuf:"varint,12,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	Status           PendingOrderStatus `protobuf:"varint,13,opt,name=status,proto3,enum=interchangenel.dex.PendingOrderStatus" json:"status,omitempty"`
+}
+
+func (m *PendingOrder) Reset()         { *m = PendingOrder{} }
+func (m *PendingOrder) String() string { return proto.CompactTextString(m) }
+func (*PendingOrder) ProtoMessage()    {}
+func (*PendingOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a785a46dd42fff3, []int{0}
+}
+func (m *PendingOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOrder.Merge(m, src)
+}
+func (m *PendingOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOrder proto.InternalMessageInfo
+
+func (m *PendingOrder) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetSide() uint32 {
+	if m != nil {
+		return m.Side
+	}
+	return 0
+}
+
+func (m *PendingOrder) GetAmountDenom() string {
+	if m != nil {
+		return m.AmountDenom
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetEscrowDenom() string {
+	if m != nil {
+		return m.EscrowDenom
+	}
+	return ""
+}
+
+func (m *PendingOrder) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *PendingOrder) GetStatus() PendingOrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PendingInFlight
+}
+
+func init() {
+	proto.RegisterEnum("interchangenel.dex.PendingOrderStatus", PendingOrderStatus_name, PendingOrderStatus_value)
+	proto.RegisterType((*PendingOrder)(nil), "interchangenel.dex.PendingOrder")
+}
+
+func init() { proto.RegisterFile("dex/pending_order.proto", fileDescriptor_9a785a46dd42fff3) }
+
+var fileDescriptor_9a785a46dd42fff3 = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xce, 0xe8, 0x76, 0xdb, 0x4e, 0x5b, 0x5d, 0x46, 0xc1, 0x61, 0x85, 0x34, 0x78, 0x28, 0x4b,
+	0xa1, 0x09, 0x28, 0xe2, 0x4d, 0x68, 0xc9, 0x6e, 0x0d, 0xe8, 0x6e, 0xc9, 0xa6, 0x17, 0x2f, 0x21,
+	0x4d, 0x7e, 0xb2, 0xc1, 0xcd, 0x4c, 0xcc, 0x4c, 0x70, 0x7d, 0x03, 0x11, 0x0f, 0xbe, 0x80, 0x27,
+	0x5f, 0xc2, 0x47, 0xe8, 0xb1, 0x47, 0xf1, 0x50, 0x64, 0xf7, 0x45, 0x24, 0x33, 0x51, 0x52, 0x16,
+	0x0f, 0x7a, 0xca, 0xff, 0x7d, 0xff, 0x7c, 0xff, 0xc7, 0x97, 0x99, 0x1f, 0x3f, 0x48, 0x60, 0xe1,
+	0x14, 0xc0, 0x92, 0x8c, 0xa5, 0x21, 0x2f, 0x13, 0x28, 0xed, 0xa2, 0xe4, 0x92, 0x13, 0x92, 0x31,
+	0x09, 0x65, 0x3c, 0x8b, 0x58, 0x0a, 0x0c, 0xe6, 0x76, 0x02, 0x8b, 0xfe, 0xfd, 0x94, 0xa7, 0x5c,
+	0xb5, 0x9d, 0xba, 0xd2, 0x27, 0x1f, 0x7d, 0xeb, 0xe0, 0xdd, 0x33, 0x3d, 0x61, 0x52, 0x0f, 0x20,
+	0x04, 0x77, 0x0a, 0x5e, 0x4a, 0x8a, 0x2c, 0x34, 0xd8, 0xf6, 0x55, 0x4d, 0x28, 0xde, 0xac, 0x67,
+	0x31, 0x98, 0xd3, 0x5b, 0x8a, 0xfe, 0x0d, 0x49, 0x1f, 0x6f, 0x09, 0x78, 0x5b, 0x01, 0x8b, 0x81,
+	0xde, 0xb6, 0xd0, 0xa0, 0xe3, 0xff, 0xc1, 0x4a, 0x55, 0x42, 0x24, 0x79, 0x49, 0x3b, 0x8d, 0x4a,
+	0xc3, 0xda, 0x43, 0x64, 0x09, 0xd0, 0x0d, 0x0b, 0x0d, 0xf6, 0x7c, 0x55, 0x13, 0x0b, 0xef, 0x44,
+	0x39, 0xaf, 0x98, 0x74, 0x81, 0xf1, 0x9c, 0x76, 0x95, 0xa2, 0x4d, 0x91, 0x11, 0xee, 0x6a, 0x48,
+	0x37, 0xeb, 0xe6, 0x89, 0x7d, 0x79, 0xbd, 0x6f, 0xfc, 0xb8, 0xde, 0x3f, 0x48, 0x33, 0x39, 0xab,
+	0x2e, 0xec, 0x98, 0xe7, 0x4e, 0xcc, 0x45, 0xce, 0x45, 0xf3, 0x39, 0x12, 0xc9, 0x1b, 0x47, 0xbe,
+	0x2f, 0x40, 0xd8, 0x1e, 0x93, 0x7e, 0xa3, 0x26, 0x26, 0xc6, 0x45, 0x99, 0xc5, 0xa0, 0x8d, 0xb6,
+	0x94, 0x51, 0x8b, 0x21, 0x2e, 0xde, 0x50, 0x88, 0x6e, 0xff, 0xb3, 0x8d, 0x0b, 0xb1, 0xaf, 0xc5,
+	0x75, 0x1e, 0x10, 0x71, 0xc9, 0xdf, 0x69, 0x1b, 0xac, 0xf3, 0xb4, 0x28, 0xe2, 0xe3, 0x5d, 0x0d,
+	0x8f, 0x75, 0xaa, 0x9d, 0xff, 0x4a, 0x75, 0x63, 0x06, 0x39, 0xc4, 0x3d, 0x99, 0xe5, 0xc0, 0x2b,
+	0x19, 0x64, 0x39, 0x08, 0x19, 0xe5, 0x05, 0xdd, 0x55, 0xf7, 0xb2, 0xc6, 0x93, 0xe7, 0xb8, 0x2b,
+	0x64, 0x24, 0x2b, 0x41, 0xf7, 0x2c, 0x34, 0xb8, 0xf3, 0xf8, 0xc0, 0x5e, 0x7f, 0x35, 0x76, 0xfb,
+	0x6d, 0x4c, 0xd5, 0x69, 0xbf, 0x51, 0x1d, 0x7e, 0x42, 0x98, 0xac, 0xb7, 0xc9, 0x33, 0x6c, 0x9e,
+	0x0d, 0xc7, 0xae, 0x37, 0x3e, 0x0d, 0x27, 0xbe, 0x3b, 0xf4, 0xc3, 0x69, 0x70, 0x1c, 0x9c, 0x4f,
+	0x43, 0x6f, 0x1c, 0x8e, 0x5e, 0x7a, 0xa7, 0x2f, 0x82, 0x9e, 0xd1, 0xbf, 0xf7, 0xf1, 0x8b, 0x75,
+	0xb7, 0xd1, 0x7a, 0x6c, 0x34, 0xcf, 0xd2, 0x99, 0xfc, 0xab, 0x30, 0xf0, 0x5e, 0x0d, 0xdd, 0x70,
+	0x72, 0x1e, 0xf4, 0xd0, 0x0d, 0x61, 0x9d, 0x24, 0x99, 0x54, 0xb2, 0xdf, 0xf9, 0xf0, 0xd5, 0x34,
+	0x4e, 0x9e, 0x5e, 0x2e, 0x4d, 0x74, 0xb5, 0x34, 0xd1, 0xcf, 0xa5, 0x89, 0x3e, 0xaf, 0x4c, 0xe3,
+	0x6a, 0x65, 0x1a, 0xdf, 0x57, 0xa6, 0xf1, 0xfa, 0x61, 0x2b, 0xd7, 0x11, 0x83, 0xb9, 0xb3, 0x70,
+	0xea, 0xc5, 0x51, 0xff, 0xf0, 0xa2, 0xab, 0xf6, 0xe0, 0xc9, 0xaf, 0x01, 0x00, 0xb6, 0x1b, 0x6b,
+	0x06, 0x4c, 0x03, 0x00, 0x00,
+}
+
+func (m *PendingOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintPendingOrder(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintPendingOrder(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.EscrowAmount.Size()
+		i -= size
+		if _, err := m.EscrowAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPendingOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.EscrowDenom) > 0 {
+		i -= len(m.EscrowDenom)
+		copy(dAtA[i:], m.EscrowDenom)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.EscrowDenom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPendingOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPendingOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.AmountDenom) > 0 {
+		i -= len(m.AmountDenom)
+		copy(dAtA[i:], m.AmountDenom)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.AmountDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Side != 0 {
+		i = encodeVarintPendingOrder(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPendingOrder(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintPendingOrder(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPendingOrder(uint64(m.Sequence))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovPendingOrder(uint64(m.Side))
+	}
+	l = len(m.AmountDenom)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPendingOrder(uint64(l))
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovPendingOrder(uint64(l))
+	l = len(m.EscrowDenom)
+	if l > 0 {
+		n += 1 + l + sovPendingOrder(uint64(l))
+	}
+	l = m.EscrowAmount.Size()
+	n += 1 + l + sovPendingOrder(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovPendingOrder(uint64(m.TimeoutTimestamp))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPendingOrder(uint64(m.Status))
+	}
+	return n
+}
+
+func sovPendingOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingOrder(x uint64) (n int) {
+	return sovPendingOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PendingOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdatePairRules = "UpdatePairRules"
+	ProposalTypeHaltPair        = "HaltPair"
+	ProposalTypeResumePair      = "ResumePair"
+	ProposalTypeDelistPair      = "DelistPair"
+)
+
+var (
+	_ govtypes.Content = &UpdatePairRulesProposal{}
+	_ govtypes.Content = &HaltPairProposal{}
+	_ govtypes.Content = &ResumePairProposal{}
+	_ govtypes.Content = &DelistPairProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdatePairRules)
+	govtypes.RegisterProposalTypeCodec(&UpdatePairRulesProposal{}, "dex/UpdatePairRulesProposal")
+	govtypes.RegisterProposalType(ProposalTypeHaltPair)
+	govtypes.RegisterProposalTypeCodec(&HaltPairProposal{}, "dex/HaltPairProposal")
+	govtypes.RegisterProposalType(ProposalTypeResumePair)
+	govtypes.RegisterProposalTypeCodec(&ResumePairProposal{}, "dex/ResumePairProposal")
+	govtypes.RegisterProposalType(ProposalTypeDelistPair)
+	govtypes.RegisterProposalTypeCodec(&DelistPairProposal{}, "dex/DelistPairProposal")
+}
+
+func NewUpdatePairRulesProposal(title, description, pairIndex string, rules PairRules) *UpdatePairRulesProposal {
+	return &UpdatePairRulesProposal{
+		Title:       title,
+		Description: description,
+		PairIndex:   pairIndex,
+		Rules:       rules,
+	}
+}
+
+func (p *UpdatePairRulesProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *UpdatePairRulesProposal) ProposalType() string {
+	return ProposalTypeUpdatePairRules
+}
+
+func (p *UpdatePairRulesProposal) ValidateBasic() error {
+	if err := validatePairProposal(p, p.PairIndex); err != nil {
+		return err
+	}
+
+	return p.Rules.Validate()
+}
+
+func NewHaltPairProposal(title, description, pairIndex string) *HaltPairProposal {
+	return &HaltPairProposal{
+		Title:       title,
+		Description: description,
+		PairIndex:   pairIndex,
+	}
+}
+
+func (p *HaltPairProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *HaltPairProposal) ProposalType() string {
+	return ProposalTypeHaltPair
+}
+
+func (p *HaltPairProposal) ValidateBasic() error {
+	return validatePairProposal(p, p.PairIndex)
+}
+
+func NewResumePairProposal(title, description, pairIndex string) *ResumePairProposal {
+	return &ResumePairProposal{
+		Title:       title,
+		Description: description,
+		PairIndex:   pairIndex,
+	}
+}
+
+func (p *ResumePairProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *ResumePairProposal) ProposalType() string {
+	return ProposalTypeResumePair
+}
+
+func (p *ResumePairProposal) ValidateBasic() error {
+	return validatePairProposal(p, p.PairIndex)
+}
+
+func NewDelistPairProposal(title, description, pairIndex string) *DelistPairProposal {
+	return &DelistPairProposal{
+		Title:       title,
+		Description: description,
+		PairIndex:   pairIndex,
+	}
+}
+
+func (p *DelistPairProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p *DelistPairProposal) ProposalType() string {
+	return ProposalTypeDelistPair
+}
+
+func (p *DelistPairProposal) ValidateBasic() error {
+	return validatePairProposal(p, p.PairIndex)
+}
+
+// validatePairProposal checks the title and description of a proposal targeting a pair and its pair index
+func validatePairProposal(content govtypes.Content, pairIndex string) error {
+	if err := govtypes.ValidateAbstract(content); err != nil {
+		return err
+	}
+	if pairIndex == "" {
+		return errors.New("invalid pair index")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestUpdatePairRulesProposalValidateBasic(t *testing.T) {
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "foo", "bar")
+
+	require.NoError(t, types.NewUpdatePairRulesProposal("title", "description", pairIndex, testPairRules).ValidateBasic())
+	require.Error(t, types.NewUpdatePairRulesProposal("", "description", pairIndex, testPairRules).ValidateBasic())
+	require.Error(t, types.NewUpdatePairRulesProposal("title", "description", "", testPairRules).ValidateBasic())
+
+	rules := testPairRules
+	rules.LotSize = sdk.NewInt(-1)
+	require.ErrorIs(t, types.NewUpdatePairRulesProposal("title", "description", pairIndex, rules).ValidateBasic(), types.ErrInvalidPairRules)
+}
+
+func TestPairStatusProposalsValidateBasic(t *testing.T) {
+	pairIndex := types.OrderBookIndex("dex", "channel-0", "foo", "bar")
+
+	for _, newProposal := range []func(title, description, pairIndex string) govtypes.Content{
+		func(title, description, pairIndex string) govtypes.Content {
+			return types.NewHaltPairProposal(title, description, pairIndex)
+		},
+		func(title, description, pairIndex string) govtypes.Content {
+			return types.NewResumePairProposal(title, description, pairIndex)
+		},
+		func(title, description, pairIndex string) govtypes.Content {
+			return types.NewDelistPairProposal(title, description, pairIndex)
+		},
+	} {
+		proposal := newProposal("title", "description", pairIndex)
+		require.NoError(t, proposal.ValidateBasic())
+		require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+		require.Error(t, newProposal("", "description", pairIndex).ValidateBasic())
+		require.Error(t, newProposal("title", "description", "").ValidateBasic())
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var ErrInvalidSelfTradePrevention = errors.New("invalid self-trade prevention")
+
+// Validate checks that the self-trade prevention mode is known
+func (s SelfTradePrevention) Validate() error {
+	if _, ok := SelfTradePrevention_name[int32(s)]; !ok {
+		return ErrInvalidSelfTradePrevention
+	}
+
+	return nil
+}
+
+// SelfTrade records what self-trade prevention cancelled while filling an order
+type SelfTrade struct {
+	// Resting are the resting orders cancelled or decremented, with the amount to refund to their creator
+	Resting []Order
+	// Incoming is the amount of the incoming order cancelled
+	Incoming sdk.Int
+}
+
+// NewSelfTrade returns an empty self-trade record
+func NewSelfTrade() SelfTrade {
+	return SelfTrade{
+		Incoming: sdk.ZeroInt(),
+	}
+}
+
+// IsSelfTrade returns whether an incoming order would trade against a resting order of its own creator
+func IsSelfTrade(incoming Order, resting Order) bool {
+	return incoming.Creator != "" && incoming.Creator == resting.Creator
+}
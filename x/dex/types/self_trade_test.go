@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestSelfTradePreventionValidate(t *testing.T) {
+	for _, mode := range []types.SelfTradePrevention{types.CancelResting, types.CancelIncoming, types.DecrementBoth} {
+		require.NoError(t, mode.Validate())
+	}
+	require.ErrorIs(t, types.SelfTradePrevention(3).Validate(), types.ErrInvalidSelfTradePrevention)
+}
+
+func TestIsSelfTrade(t *testing.T) {
+	require.True(t, types.IsSelfTrade(types.Order{Creator: "alice"}, types.Order{Creator: "alice"}))
+	require.False(t, types.IsSelfTrade(types.Order{Creator: "alice"}, types.Order{Creator: "bob"}))
+
+	// orders without creator are never self-trades
+	require.False(t, types.IsSelfTrade(types.Order{}, types.Order{}))
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -27,6 +28,12 @@ type SellOrderBook struct {
 	AmountDenom string     `protobuf:"bytes,2,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
 	PriceDenom  string     `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
 	Book        *OrderBook `protobuf:"bytes,4,opt,name=book,proto3" json:"book,omitempty"`
+	// port and channel escrowing the orders of the book on this chain
+	Port    string    `protobuf:"bytes,5,opt,name=port,proto3" json:"port,omitempty"`
+	Channel string    `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Rules   PairRules `protobuf:"bytes,7,opt,name=rules,proto3" json:"rules"`
+	// halted books reject the new orders of the pair until a governance proposal resumes it
+	Halted bool `protobuf:"varint,8,opt,name=halted,proto3" json:"halted,omitempty"`
 }
 
 func (m *SellOrderBook) Reset()         { *m = SellOrderBook{} }
@@ -90,6 +97,34 @@ func (m *SellOrderBook) GetBook() *OrderBook {
 	return nil
 }
 
+func (m *SellOrderBook) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *SellOrderBook) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SellOrderBook) GetRules() PairRules {
+	if m != nil {
+		return m.Rules
+	}
+	return PairRules{}
+}
+
+func (m *SellOrderBook) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*SellOrderBook)(nil), "interchangenel.dex.SellOrderBook")
 }
@@ -97,22 +132,27 @@ func init() {
 func init() { proto.RegisterFile("dex/sell_order_book.proto", fileDescriptor_98da59115168fe9e) }
 
 var fileDescriptor_98da59115168fe9e = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x49, 0xad, 0xd0,
-	0x2f, 0x4e, 0xcd, 0xc9, 0x89, 0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0x8a, 0x4f, 0xca, 0xcf, 0xcf, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc,
-	0x4b, 0x4f, 0xcd, 0x4b, 0xcd, 0xd1, 0x4b, 0x49, 0xad, 0x90, 0xe2, 0x07, 0x29, 0x07, 0xab, 0x84,
-	0x28, 0x52, 0x9a, 0xc5, 0xc8, 0xc5, 0x1b, 0x9c, 0x9a, 0x93, 0xe3, 0x0f, 0x12, 0x73, 0xca, 0xcf,
-	0xcf, 0x16, 0x12, 0xe1, 0x62, 0xcd, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x0c, 0x82, 0x70, 0x84, 0x14, 0xb8, 0xb8, 0x13, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x5c, 0x52, 0xf3,
-	0xf2, 0x73, 0x25, 0x98, 0xc0, 0x72, 0xc8, 0x42, 0x42, 0x72, 0x5c, 0x5c, 0x05, 0x45, 0x99, 0xc9,
-	0xa9, 0x10, 0x05, 0xcc, 0x60, 0x05, 0x48, 0x22, 0x42, 0x86, 0x5c, 0x2c, 0x20, 0xc7, 0x49, 0xb0,
-	0x28, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xea, 0x61, 0xba, 0x4e, 0x0f, 0xee, 0x88, 0x20, 0xb0, 0x52,
-	0x27, 0xd3, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x92, 0x46, 0xd2, 0xad,
-	0x9b, 0x97, 0x9a, 0xa3, 0x5f, 0xa1, 0x0f, 0xf2, 0x59, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0xd8, 0x6b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xe1, 0xb4, 0xf2, 0x1c, 0x01, 0x00,
-	0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcd, 0x4a, 0xc3, 0x40,
+	0x10, 0x80, 0xb3, 0x35, 0xfd, 0x71, 0x8b, 0x08, 0x4b, 0x91, 0xb5, 0xe2, 0x1a, 0x3c, 0xf5, 0x62,
+	0x82, 0x8a, 0x07, 0xaf, 0xc5, 0xbb, 0x12, 0x6f, 0x5e, 0x4a, 0xda, 0x0c, 0x6d, 0xe8, 0x76, 0x27,
+	0x6c, 0xb7, 0x10, 0xdf, 0xc2, 0xbb, 0x2f, 0xd4, 0x63, 0x8f, 0x9e, 0x44, 0x92, 0x17, 0x91, 0xdd,
+	0x54, 0x09, 0x88, 0xb7, 0x99, 0x6f, 0xbe, 0x99, 0xdd, 0x19, 0x7a, 0x9a, 0x42, 0x11, 0xad, 0x41,
+	0xca, 0x09, 0xea, 0x14, 0xf4, 0x64, 0x8a, 0xb8, 0x0c, 0x73, 0x8d, 0x06, 0x19, 0xcb, 0x94, 0x01,
+	0x3d, 0x5b, 0x24, 0x6a, 0x0e, 0x0a, 0x64, 0x98, 0x42, 0x31, 0x1c, 0xcc, 0x71, 0x8e, 0xae, 0x1c,
+	0xd9, 0xa8, 0x36, 0x87, 0xc7, 0x76, 0x88, 0xeb, 0xdf, 0x83, 0x81, 0x05, 0x79, 0x92, 0xe9, 0x89,
+	0xde, 0x48, 0x58, 0xd7, 0xf4, 0xf2, 0xbd, 0x45, 0x8f, 0x9e, 0x41, 0xca, 0x47, 0x6b, 0x8e, 0x11,
+	0x97, 0x6c, 0x40, 0xdb, 0x99, 0x4a, 0xa1, 0xe0, 0x24, 0x20, 0xa3, 0xc3, 0xb8, 0x4e, 0x58, 0x40,
+	0xfb, 0xc9, 0x0a, 0x37, 0xca, 0x3c, 0x80, 0xc2, 0x15, 0x6f, 0xb9, 0x5a, 0x13, 0x31, 0x41, 0x69,
+	0xae, 0xb3, 0x19, 0xd4, 0xc2, 0x81, 0x13, 0x1a, 0x84, 0x5d, 0x53, 0xdf, 0x2e, 0xc2, 0xfd, 0x80,
+	0x8c, 0xfa, 0x37, 0xe7, 0xe1, 0xdf, 0x4d, 0xc2, 0xdf, 0x4f, 0xc4, 0x4e, 0x65, 0x8c, 0xfa, 0x39,
+	0x6a, 0xc3, 0xdb, 0x6e, 0x98, 0x8b, 0x19, 0xa7, 0x5d, 0xdb, 0xa4, 0x40, 0xf2, 0x8e, 0xc3, 0x3f,
+	0x29, 0xbb, 0xa7, 0x6d, 0xb7, 0x19, 0xef, 0xfe, 0xff, 0xc2, 0x53, 0x92, 0xe9, 0xd8, 0x4a, 0x63,
+	0x7f, 0xfb, 0x79, 0xe1, 0xc5, 0x75, 0x07, 0x3b, 0xa1, 0x9d, 0x45, 0x22, 0x0d, 0xa4, 0xbc, 0x17,
+	0x90, 0x51, 0x2f, 0xde, 0x67, 0xe3, 0xbb, 0x6d, 0x29, 0xc8, 0xae, 0x14, 0xe4, 0xab, 0x14, 0xe4,
+	0xad, 0x12, 0xde, 0xae, 0x12, 0xde, 0x47, 0x25, 0xbc, 0x97, 0xb3, 0xc6, 0xf0, 0x2b, 0x05, 0x32,
+	0x2a, 0x22, 0x7b, 0x5f, 0xf3, 0x9a, 0xc3, 0x7a, 0xda, 0x71, 0xb7, 0xbd, 0xfd, 0x1e, 0x00, 0x3a,
+	0x1d, 0x44, 0xab, 0xc9, 0x01, 0x00, 0x00,
 }
 
 func (m *SellOrderBook) Marshal() (dAtA []byte, err error) {
@@ -135,6 +175,40 @@ func (m *SellOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSellOrderBook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintSellOrderBook(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintSellOrderBook(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Book != nil {
 		{
 			size, err := m.Book.MarshalToSizedBuffer(dAtA[:i])
@@ -204,6 +278,19 @@ func (m *SellOrderBook) Size() (n int) {
 		l = m.Book.Size()
 		n += 1 + l + sovSellOrderBook(uint64(l))
 	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovSellOrderBook(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovSellOrderBook(uint64(l))
+	}
+	l = m.Rules.Size()
+	n += 1 + l + sovSellOrderBook(uint64(l))
+	if m.Halted {
+		n += 2
+	}
 	return n
 }
 
@@ -374,6 +461,123 @@ func (m *SellOrderBook) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSellOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSellOrderBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSellOrderBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSellOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSellOrderBook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSellOrderBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSellOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSellOrderBook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSellOrderBook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSellOrderBook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSellOrderBook(dAtA[iNdEx:])
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ErrStopOrderNotFound = errors.New("stop order not found")
+	ErrStopPriceCrossed  = errors.New("the last price has already crossed the stop price")
+)
+
+// Side returns the side of the order book the stop order is sent to
+func (o StopOrder) Side() OrderSide {
+	if o.GetBuyOrder() != nil {
+		return BuySide
+	}
+
+	return SellSide
+}
+
+// PairIndex returns the index of the pair of the stop order
+func (o StopOrder) PairIndex() string {
+	if buyOrder := o.GetBuyOrder(); buyOrder != nil {
+		return OrderBookIndex(o.Port, o.ChannelID, buyOrder.AmountDenom, buyOrder.PriceDenom)
+	}
+
+	sellOrder := o.GetSellOrder()
+	return OrderBookIndex(o.Port, o.ChannelID, sellOrder.AmountDenom, sellOrder.PriceDenom)
+}
+
+// IsStopTriggered returns whether a last price triggers a stop price: sell stops trigger when the price
+// falls to their stop price and buy stops when it rises to it
+func IsStopTriggered(side OrderSide, stopPrice sdk.Dec, lastPrice sdk.Dec) bool {
+	if side == BuySide {
+		return lastPrice.GTE(stopPrice)
+	}
+
+	return lastPrice.LTE(stopPrice)
+}
@@ -0,0 +1,749 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/stop_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StopOrder is an order escrowed on this chain until the last price of its pair crosses its stop price,
+// it is then sent to the counterparty chain like any other order
+type StopOrder struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator   string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port      string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID string `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	// relativeTimeout is the timeout of the order packet in nanoseconds after the order is triggered
+	RelativeTimeout uint64                                 `protobuf:"varint,5,opt,name=relativeTimeout,proto3" json:"relativeTimeout,omitempty"`
+	StopPrice       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=stopPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stopPrice"`
+	// Types that are valid to be assigned to Packet:
+	//	*StopOrder_SellOrder
+	//	*StopOrder_BuyOrder
+	Packet isStopOrder_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *StopOrder) Reset()         { *m = StopOrder{} }
+func (m *StopOrder) String() string { return proto.CompactTextString(m) }
+func (*StopOrder) ProtoMessage()    {}
+func (*StopOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e5ec8fa6abe7072, []int{0}
+}
+func (m *StopOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopOrder.Merge(m, src)
+}
+func (m *StopOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopOrder proto.InternalMessageInfo
+
+type isStopOrder_Packet interface {
+	isStopOrder_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StopOrder_SellOrder struct {
+	SellOrder *SellOrderPacketData `protobuf:"bytes,7,opt,name=sellOrder,proto3,oneof" json:"sellOrder,omitempty"`
+}
+type StopOrder_BuyOrder struct {
+	BuyOrder *BuyOrderPacketData `protobuf:"bytes,8,opt,name=buyOrder,proto3,oneof" json:"buyOrder,omitempty"`
+}
+
+func (*StopOrder_SellOrder) isStopOrder_Packet() {}
+func (*StopOrder_BuyOrder) isStopOrder_Packet()  {}
+
+func (m *StopOrder) GetPacket() isStopOrder_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *StopOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StopOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *StopOrder) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *StopOrder) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *StopOrder) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+func (m *StopOrder) GetSellOrder() *SellOrderPacketData {
+	if x, ok := m.GetPacket().(*StopOrder_SellOrder); ok {
+		return x.SellOrder
+	}
+	return nil
+}
+
+func (m *StopOrder) GetBuyOrder() *BuyOrderPacketData {
+	if x, ok := m.GetPacket().(*StopOrder_BuyOrder); ok {
+		return x.BuyOrder
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StopOrder) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StopOrder_SellOrder)(nil),
+		(*StopOrder_BuyOrder)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*StopOrder)(nil), "interchangenel.dex.StopOrder")
+}
+
+func init() { proto.RegisterFile("dex/stop_order.proto", fileDescriptor_6e5ec8fa6abe7072) }
+
+var fileDescriptor_6e5ec8fa6abe7072 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0x7c, 0xfd, 0xda, 0x66, 0x05, 0x95, 0xa5, 0x87, 0xa5, 0x4a, 0x5a, 0x3c, 0xd4,
+	0x5c, 0x9a, 0x80, 0xe2, 0x0b, 0x84, 0x80, 0x0a, 0x82, 0x25, 0xf5, 0xe4, 0x45, 0xd2, 0x64, 0x48,
+	0x97, 0xa6, 0xd9, 0xb0, 0xd9, 0x48, 0xfb, 0x16, 0x3e, 0x56, 0x8f, 0x3d, 0x8a, 0x87, 0x22, 0xed,
+	0x4b, 0x78, 0x94, 0xdd, 0x58, 0x2b, 0xd6, 0xd3, 0xce, 0xfe, 0x67, 0xe6, 0xb7, 0x3b, 0xf3, 0x47,
+	0xad, 0x18, 0x66, 0x6e, 0x21, 0x58, 0xfe, 0xc4, 0x78, 0x0c, 0xdc, 0xc9, 0x39, 0x13, 0x0c, 0x63,
+	0x9a, 0x09, 0xe0, 0xd1, 0x38, 0xcc, 0x12, 0xc8, 0x20, 0x75, 0x62, 0x98, 0xb5, 0x5b, 0x09, 0x4b,
+	0x98, 0x4a, 0xbb, 0x32, 0xaa, 0x2a, 0xdb, 0xc7, 0xb2, 0x3f, 0x0f, 0xa3, 0x09, 0x88, 0x4a, 0x39,
+	0xfb, 0x30, 0x90, 0x39, 0x14, 0x2c, 0xbf, 0x97, 0x3c, 0x7c, 0x88, 0x0c, 0x1a, 0x13, 0xbd, 0xab,
+	0xdb, 0xb5, 0xc0, 0xa0, 0x31, 0x26, 0xa8, 0x11, 0x71, 0x08, 0x05, 0xe3, 0xc4, 0xe8, 0xea, 0xb6,
+	0x19, 0x6c, 0xaf, 0x18, 0xa3, 0x5a, 0xce, 0xb8, 0x20, 0xff, 0x94, 0xac, 0x62, 0x7c, 0x8a, 0x4c,
+	0xf9, 0x89, 0x0c, 0xd2, 0x5b, 0x9f, 0xd4, 0x54, 0x62, 0x27, 0x60, 0x1b, 0x1d, 0x71, 0x48, 0x43,
+	0x41, 0x9f, 0xe1, 0x81, 0x4e, 0x81, 0x95, 0x82, 0xfc, 0x57, 0x0f, 0xfd, 0x96, 0xf1, 0x1d, 0x32,
+	0xe5, 0x8c, 0x03, 0x4e, 0x23, 0x20, 0x75, 0xc9, 0xf1, 0x9c, 0xc5, 0xaa, 0xa3, 0xbd, 0xad, 0x3a,
+	0xbd, 0x84, 0x8a, 0x71, 0x39, 0x72, 0x22, 0x36, 0x75, 0x23, 0x56, 0x4c, 0x59, 0xf1, 0x75, 0xf4,
+	0x8b, 0x78, 0xe2, 0x8a, 0x79, 0x0e, 0x85, 0xe3, 0x43, 0x14, 0xec, 0x00, 0xf8, 0x1a, 0x99, 0x05,
+	0xa4, 0xa9, 0x1a, 0x90, 0x34, 0xba, 0xba, 0x7d, 0x70, 0x71, 0xee, 0xec, 0x6f, 0xcc, 0x19, 0x6e,
+	0x8b, 0x06, 0x6a, 0x3f, 0x7e, 0x28, 0xc2, 0x1b, 0x2d, 0xd8, 0xf5, 0x62, 0x1f, 0x35, 0x47, 0xe5,
+	0xbc, 0xe2, 0x34, 0x15, 0xa7, 0xf7, 0x17, 0xc7, 0x2b, 0xe7, 0xfb, 0x98, 0xef, 0x4e, 0xaf, 0x89,
+	0xea, 0x95, 0x01, 0xde, 0xd5, 0x62, 0x6d, 0xe9, 0xcb, 0xb5, 0xa5, 0xbf, 0xaf, 0x2d, 0xfd, 0x65,
+	0x63, 0x69, 0xcb, 0x8d, 0xa5, 0xbd, 0x6e, 0x2c, 0xed, 0xf1, 0xe4, 0x07, 0xb6, 0x9f, 0x41, 0xea,
+	0xce, 0x5c, 0x69, 0x9c, 0x1a, 0x6f, 0x54, 0x57, 0xc6, 0x5d, 0x7e, 0x0e, 0x00, 0xf0, 0xfd, 0x81,
+	0xe9, 0x0c, 0x02, 0x00, 0x00,
+}
+
+func (m *StopOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	{
+		size := m.StopPrice.Size()
+		i -= size
+		if _, err := m.StopPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStopOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintStopOrder(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintStopOrder(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintStopOrder(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStopOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStopOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopOrder_SellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopOrder_SellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SellOrder != nil {
+		{
+			size, err := m.SellOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStopOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StopOrder_BuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopOrder_BuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BuyOrder != nil {
+		{
+			size, err := m.BuyOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStopOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintStopOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStopOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StopOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStopOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovStopOrder(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovStopOrder(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovStopOrder(uint64(l))
+	}
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovStopOrder(uint64(m.RelativeTimeout))
+	}
+	l = m.StopPrice.Size()
+	n += 1 + l + sovStopOrder(uint64(l))
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *StopOrder_SellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrder != nil {
+		l = m.SellOrder.Size()
+		n += 1 + l + sovStopOrder(uint64(l))
+	}
+	return n
+}
+func (m *StopOrder_BuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrder != nil {
+		l = m.BuyOrder.Size()
+		n += 1 + l + sovStopOrder(uint64(l))
+	}
+	return n
+}
+
+func sovStopOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStopOrder(x uint64) (n int) {
+	return sovStopOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StopOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStopOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SellOrderPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &StopOrder_SellOrder{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BuyOrderPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &StopOrder_BuyOrder{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStopOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStopOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStopOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStopOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStopOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStopOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStopOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStopOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStopOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStopOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStopOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"interchange-nel/x/dex/types"
+)
+
+func TestIsStopTriggered(t *testing.T) {
+	// sell stops trigger when the price falls to the stop price
+	require.False(t, types.IsStopTriggered(types.SellSide, sdk.NewDec(10), sdk.NewDec(11)))
+	require.True(t, types.IsStopTriggered(types.SellSide, sdk.NewDec(10), sdk.NewDec(10)))
+	require.True(t, types.IsStopTriggered(types.SellSide, sdk.NewDec(10), sdk.NewDec(9)))
+
+	// buy stops trigger when the price rises to the stop price
+	require.False(t, types.IsStopTriggered(types.BuySide, sdk.NewDec(10), sdk.NewDec(9)))
+	require.True(t, types.IsStopTriggered(types.BuySide, sdk.NewDec(10), sdk.NewDec(10)))
+	require.True(t, types.IsStopTriggered(types.BuySide, sdk.NewDec(10), sdk.NewDec(11)))
+}
+
+func TestStopOrder_PairIndex(t *testing.T) {
+	stopOrder := types.StopOrder{
+		Port:      "dex",
+		ChannelID: "channel-0",
+		Packet:    &types.StopOrder_BuyOrder{BuyOrder: &types.BuyOrderPacketData{AmountDenom: "foo", PriceDenom: "bar"}},
+	}
+	require.Equal(t, types.BuySide, stopOrder.Side())
+	require.Equal(t, types.OrderBookIndex("dex", "channel-0", "foo", "bar"), stopOrder.PairIndex())
+
+	stopOrder.Packet = &types.StopOrder_SellOrder{SellOrder: &types.SellOrderPacketData{AmountDenom: "foo", PriceDenom: "bar"}}
+	require.Equal(t, types.SellSide, stopOrder.Side())
+	require.Equal(t, types.OrderBookIndex("dex", "channel-0", "foo", "bar"), stopOrder.PairIndex())
+}
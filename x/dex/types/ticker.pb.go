@@ -0,0 +1,599 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/ticker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Ticker summarises the books and the last trade of a pair on this chain. The prices of a side are zero
+// when it is empty or held by the counterparty chain, the spread and the mid price when either side is
+// missing
+type Ticker struct {
+	Index     string                                 `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	BestBid   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bestBid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bestBid"`
+	BestAsk   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bestAsk,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bestAsk"`
+	Spread    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread"`
+	MidPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=midPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"midPrice"`
+	LastPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lastPrice"`
+	// timestamp is the block time of the last trade in unix nanoseconds
+	Timestamp uint64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Ticker) Reset()         { *m = Ticker{} }
+func (m *Ticker) String() string { return proto.CompactTextString(m) }
+func (*Ticker) ProtoMessage()    {}
+func (*Ticker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a1e5f27eff23b34, []int{0}
+}
+func (m *Ticker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ticker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ticker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ticker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ticker.Merge(m, src)
+}
+func (m *Ticker) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ticker) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ticker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ticker proto.InternalMessageInfo
+
+func (m *Ticker) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Ticker) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Ticker)(nil), "interchangenel.dex.Ticker")
+}
+
+func init() { proto.RegisterFile("dex/ticker.proto", fileDescriptor_9a1e5f27eff23b34) }
+
+var fileDescriptor_9a1e5f27eff23b34 = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3d, 0x4e, 0xc3, 0x30,
+	0x18, 0x86, 0x63, 0xda, 0xa6, 0xd4, 0x13, 0xb2, 0x3a, 0x58, 0x80, 0xdc, 0x8a, 0x01, 0x75, 0x69,
+	0x3c, 0x20, 0x0e, 0xd0, 0x0a, 0x21, 0x84, 0x18, 0x50, 0xc5, 0xc4, 0x96, 0xd8, 0x9f, 0x52, 0x2b,
+	0x3f, 0x8e, 0x62, 0x23, 0x85, 0x5b, 0x70, 0x16, 0x4e, 0xd1, 0xb1, 0x23, 0x62, 0xa8, 0x50, 0x72,
+	0x11, 0x94, 0xa4, 0xa5, 0xcc, 0x99, 0x6c, 0xbf, 0xf6, 0xf3, 0x78, 0x78, 0x3f, 0x7c, 0x26, 0xa1,
+	0xe0, 0x56, 0x89, 0x08, 0x72, 0x2f, 0xcb, 0xb5, 0xd5, 0x84, 0xa8, 0xd4, 0x42, 0x2e, 0xd6, 0x7e,
+	0x1a, 0x42, 0x0a, 0xb1, 0x27, 0xa1, 0x38, 0x1f, 0x87, 0x3a, 0xd4, 0xcd, 0x35, 0xaf, 0x77, 0xed,
+	0xcb, 0xab, 0xcf, 0x1e, 0x76, 0x5f, 0x1a, 0x94, 0x8c, 0xf1, 0x40, 0xa5, 0x12, 0x0a, 0x8a, 0xa6,
+	0x68, 0x36, 0x5a, 0xb5, 0x07, 0xf2, 0x80, 0x87, 0x01, 0x18, 0xbb, 0x54, 0x92, 0x9e, 0xd4, 0xf9,
+	0xd2, 0xdb, 0xec, 0x26, 0xce, 0xf7, 0x6e, 0x72, 0x1d, 0x2a, 0xbb, 0x7e, 0x0b, 0x3c, 0xa1, 0x13,
+	0x2e, 0xb4, 0x49, 0xb4, 0xd9, 0x2f, 0x73, 0x23, 0x23, 0x6e, 0xdf, 0x33, 0x30, 0xde, 0x1d, 0x88,
+	0xd5, 0x01, 0x3f, 0x98, 0x16, 0x26, 0xa2, 0xbd, 0xee, 0xa6, 0x85, 0x89, 0xc8, 0x3d, 0x76, 0x4d,
+	0x96, 0x83, 0x2f, 0x69, 0xbf, 0x93, 0x68, 0x4f, 0x93, 0x47, 0x7c, 0x9a, 0x28, 0xf9, 0x9c, 0x2b,
+	0x01, 0x74, 0xd0, 0xc9, 0xf4, 0xc7, 0x93, 0x27, 0x3c, 0x8a, 0x7d, 0x63, 0x5b, 0x99, 0xdb, 0x49,
+	0x76, 0x14, 0x90, 0x4b, 0x3c, 0xb2, 0x2a, 0x01, 0x63, 0xfd, 0x24, 0xa3, 0xc3, 0x29, 0x9a, 0xf5,
+	0x57, 0xc7, 0x60, 0x79, 0xbb, 0x29, 0x19, 0xda, 0x96, 0x0c, 0xfd, 0x94, 0x0c, 0x7d, 0x54, 0xcc,
+	0xd9, 0x56, 0xcc, 0xf9, 0xaa, 0x98, 0xf3, 0x7a, 0xf1, 0xaf, 0xf8, 0x79, 0x0a, 0x31, 0x2f, 0x78,
+	0x33, 0x1c, 0xf5, 0x1f, 0x81, 0xdb, 0x54, 0x7e, 0xf3, 0x3b, 0x00, 0x25, 0x0c, 0x22, 0x9a, 0x30,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Ticker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ticker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTicker(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MidPrice.Size()
+		i -= size
+		if _, err := m.MidPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Spread.Size()
+		i -= size
+		if _, err := m.Spread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BestAsk.Size()
+		i -= size
+		if _, err := m.BestAsk.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BestBid.Size()
+		i -= size
+		if _, err := m.BestBid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTicker(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTicker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTicker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Ticker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTicker(uint64(l))
+	}
+	l = m.BestBid.Size()
+	n += 1 + l + sovTicker(uint64(l))
+	l = m.BestAsk.Size()
+	n += 1 + l + sovTicker(uint64(l))
+	l = m.Spread.Size()
+	n += 1 + l + sovTicker(uint64(l))
+	l = m.MidPrice.Size()
+	n += 1 + l + sovTicker(uint64(l))
+	l = m.LastPrice.Size()
+	n += 1 + l + sovTicker(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovTicker(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovTicker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTicker(x uint64) (n int) {
+	return sovTicker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Ticker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ticker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ticker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BestAsk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MidPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTicker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTicker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTicker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTicker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTicker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTicker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTicker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTicker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTicker = fmt.Errorf("proto: unexpected end of group")
+)
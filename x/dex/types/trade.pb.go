@@ -0,0 +1,705 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/trade.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Trade is the record of an execution between an incoming order and a resting order of a pair, kept on
+// both the chain holding the book and the chain the incoming order was sent from
+type Trade struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PairIndex    string `protobuf:"bytes,2,opt,name=pairIndex,proto3" json:"pairIndex,omitempty"`
+	MakerOrderId int32  `protobuf:"varint,3,opt,name=makerOrderId,proto3" json:"makerOrderId,omitempty"`
+	// maker is the creator of the resting order
+	Maker string `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty"`
+	// taker is the creator of the incoming order
+	Taker  string                                 `protobuf:"bytes,5,opt,name=taker,proto3" json:"taker,omitempty"`
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// height is the height of the block executing the trade on the chain holding the book
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the time of the block executing the trade in unix nanoseconds
+	Timestamp uint64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// packetSequence is the sequence of the packet of the incoming order
+	PacketSequence uint64 `protobuf:"varint,10,opt,name=packetSequence,proto3" json:"packetSequence,omitempty"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_534ab4dd86b8fc44, []int{0}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Trade) GetPairIndex() string {
+	if m != nil {
+		return m.PairIndex
+	}
+	return ""
+}
+
+func (m *Trade) GetMakerOrderId() int32 {
+	if m != nil {
+		return m.MakerOrderId
+	}
+	return 0
+}
+
+func (m *Trade) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *Trade) GetTaker() string {
+	if m != nil {
+		return m.Taker
+	}
+	return ""
+}
+
+func (m *Trade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Trade) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Trade) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Trade)(nil), "interchangenel.dex.Trade")
+}
+
+func init() { proto.RegisterFile("dex/trade.proto", fileDescriptor_534ab4dd86b8fc44) }
+
+var fileDescriptor_534ab4dd86b8fc44 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x6a, 0xf3, 0x30,
+	0x14, 0x85, 0x2d, 0x27, 0xf6, 0xff, 0x47, 0x94, 0x14, 0x44, 0x28, 0xa2, 0x2d, 0x8e, 0xc9, 0x10,
+	0xbc, 0xc4, 0x1e, 0x4a, 0x5f, 0x20, 0x84, 0x42, 0xa6, 0x82, 0xdb, 0xa9, 0x9b, 0x63, 0x5d, 0x6c,
+	0x91, 0x58, 0x72, 0x65, 0x05, 0xdc, 0xb7, 0xe8, 0x63, 0x65, 0xcc, 0x58, 0x3a, 0x84, 0x92, 0x3c,
+	0x41, 0xdf, 0xa0, 0x58, 0x0e, 0xa4, 0xed, 0xd6, 0x49, 0x3a, 0x9f, 0xee, 0x11, 0x97, 0x73, 0xf0,
+	0x39, 0x83, 0x3a, 0xd2, 0x2a, 0x61, 0x10, 0x96, 0x4a, 0x6a, 0x49, 0x08, 0x17, 0x1a, 0x54, 0x9a,
+	0x27, 0x22, 0x03, 0x01, 0xab, 0x90, 0x41, 0x7d, 0x39, 0xc8, 0x64, 0x26, 0xcd, 0x73, 0xd4, 0xdc,
+	0xda, 0xc9, 0xd1, 0xa7, 0x8d, 0x9d, 0xc7, 0xc6, 0x49, 0xfa, 0xd8, 0xe6, 0x8c, 0x22, 0x1f, 0x05,
+	0xdd, 0xd8, 0xe6, 0x8c, 0x5c, 0xe3, 0x5e, 0x99, 0x70, 0x35, 0x17, 0x0c, 0x6a, 0x6a, 0xfb, 0x28,
+	0xe8, 0xc5, 0x27, 0x40, 0x46, 0xf8, 0xac, 0x48, 0x96, 0xa0, 0xee, 0x15, 0x03, 0x35, 0x67, 0xb4,
+	0xe3, 0xa3, 0xc0, 0x89, 0x7f, 0x30, 0x32, 0xc0, 0x8e, 0xd1, 0xb4, 0x6b, 0xdc, 0xad, 0x68, 0xa8,
+	0x36, 0xd4, 0x69, 0xa9, 0x11, 0x64, 0x86, 0x9d, 0x52, 0xf1, 0x14, 0xa8, 0xdb, 0xd0, 0x69, 0xb8,
+	0xd9, 0x0d, 0xad, 0xf7, 0xdd, 0x70, 0x9c, 0x71, 0x9d, 0xaf, 0x17, 0x61, 0x2a, 0x8b, 0x28, 0x95,
+	0x55, 0x21, 0xab, 0xe3, 0x31, 0xa9, 0xd8, 0x32, 0xd2, 0x2f, 0x25, 0x54, 0xe1, 0x0c, 0xd2, 0xb8,
+	0x35, 0x93, 0x3b, 0xec, 0x26, 0x85, 0x5c, 0x0b, 0x4d, 0xff, 0xfd, 0xf9, 0x9b, 0xb9, 0xd0, 0xf1,
+	0xd1, 0x4d, 0x2e, 0xb0, 0x9b, 0x03, 0xcf, 0x72, 0x4d, 0xff, 0xfb, 0x28, 0xe8, 0xc4, 0x47, 0xd5,
+	0x64, 0xa2, 0x79, 0x01, 0x95, 0x4e, 0x8a, 0x92, 0xf6, 0x4c, 0x54, 0x27, 0x40, 0xc6, 0xb8, 0x5f,
+	0x26, 0xe9, 0x12, 0xf4, 0x03, 0x3c, 0xaf, 0x41, 0xa4, 0x40, 0xb1, 0x19, 0xf9, 0x45, 0xa7, 0xb7,
+	0x9b, 0xbd, 0x87, 0xb6, 0x7b, 0x0f, 0x7d, 0xec, 0x3d, 0xf4, 0x7a, 0xf0, 0xac, 0xed, 0xc1, 0xb3,
+	0xde, 0x0e, 0x9e, 0xf5, 0x74, 0xf5, 0xad, 0xb7, 0x89, 0x80, 0x55, 0x54, 0x47, 0xa6, 0xda, 0x66,
+	0xc1, 0x85, 0x6b, 0x1a, 0xbb, 0xf9, 0x1a, 0x00, 0xc8, 0xe9, 0xc2, 0xc7, 0xee, 0x01, 0x00, 0x00,
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PacketSequence != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Taker) > 0 {
+		i -= len(m.Taker)
+		copy(dAtA[i:], m.Taker)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.Taker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MakerOrderId != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.MakerOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PairIndex) > 0 {
+		i -= len(m.PairIndex)
+		copy(dAtA[i:], m.PairIndex)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.PairIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTrade(uint64(m.Id))
+	}
+	l = len(m.PairIndex)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	if m.MakerOrderId != 0 {
+		n += 1 + sovTrade(uint64(m.MakerOrderId))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = len(m.Taker)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTrade(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTrade(uint64(m.Timestamp))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovTrade(uint64(m.PacketSequence))
+	}
+	return n
+}
+
+func sovTrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrade(x uint64) (n int) {
+	return sovTrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderId", wireType)
+			}
+			m.MakerOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerOrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrade(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrade
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrade
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrade
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrade        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrade          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrade = fmt.Errorf("proto: unexpected end of group")
+)
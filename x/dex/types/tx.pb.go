@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -28,12 +30,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgSendCreatePair struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Port             string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
-	ChannelID        string `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
-	SourceDenom      string `protobuf:"bytes,5,opt,name=sourceDenom,proto3" json:"sourceDenom,omitempty"`
-	TargetDenom      string `protobuf:"bytes,6,opt,name=targetDenom,proto3" json:"targetDenom,omitempty"`
+	Creator          string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port             string    `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID        string    `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	TimeoutTimestamp uint64    `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	SourceDenom      string    `protobuf:"bytes,5,opt,name=sourceDenom,proto3" json:"sourceDenom,omitempty"`
+	TargetDenom      string    `protobuf:"bytes,6,opt,name=targetDenom,proto3" json:"targetDenom,omitempty"`
+	Rules            PairRules `protobuf:"bytes,7,opt,name=rules,proto3" json:"rules"`
 }
 
 func (m *MsgSendCreatePair) Reset()         { *m = MsgSendCreatePair{} }
@@ -111,6 +114,13 @@ func (m *MsgSendCreatePair) GetTargetDenom() string {
 	return ""
 }
 
+func (m *MsgSendCreatePair) GetRules() PairRules {
+	if m != nil {
+		return m.Rules
+	}
+	return PairRules{}
+}
+
 type MsgSendCreatePairResponse struct {
 }
 
@@ -148,14 +158,25 @@ func (m *MsgSendCreatePairResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgSendCreatePairResponse proto.InternalMessageInfo
 
 type MsgSendSellOrder struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Port             string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
-	ChannelID        string `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
-	AmountDenom      string `protobuf:"bytes,5,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
-	Amount           int32  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	PriceDenom       string `protobuf:"bytes,7,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
-	Price            int32  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
+	Creator          string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port             string                                 `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID        string                                 `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	TimeoutTimestamp uint64                                 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	AmountDenom      string                                 `protobuf:"bytes,5,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	PriceDenom       string                                 `protobuf:"bytes,7,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	// price is the limit price of limit orders and the protection price of market orders
+	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	OrderType   OrderType                              `protobuf:"varint,9,opt,name=orderType,proto3,enum=interchangenel.dex.OrderType" json:"orderType,omitempty"`
+	TimeInForce TimeInForce                            `protobuf:"varint,10,opt,name=timeInForce,proto3,enum=interchangenel.dex.TimeInForce" json:"timeInForce,omitempty"`
+	Expiry      OrderExpiry                            `protobuf:"bytes,11,opt,name=expiry,proto3" json:"expiry"`
+	// post-only orders are rejected instead of matching on reception
+	PostOnly bool `protobuf:"varint,12,opt,name=postOnly,proto3" json:"postOnly,omitempty"`
+	// stop orders are escrowed until the last price of the pair crosses their stop price, zero for none
+	StopPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=stopPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stopPrice"`
+	// iceberg orders only show displayAmount on the book, the rest is hidden in reserve, zero for none
+	DisplayAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=displayAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"displayAmount"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,15,opt,name=selfTradePrevention,proto3,enum=interchangenel.dex.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
 }
 
 func (m *MsgSendSellOrder) Reset()         { *m = MsgSendSellOrder{} }
@@ -226,28 +247,51 @@ func (m *MsgSendSellOrder) GetAmountDenom() string {
 	return ""
 }
 
-func (m *MsgSendSellOrder) GetAmount() int32 {
+func (m *MsgSendSellOrder) GetPriceDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.PriceDenom
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgSendSellOrder) GetPriceDenom() string {
+func (m *MsgSendSellOrder) GetOrderType() OrderType {
 	if m != nil {
-		return m.PriceDenom
+		return m.OrderType
 	}
-	return ""
+	return LimitOrder
 }
 
-func (m *MsgSendSellOrder) GetPrice() int32 {
+func (m *MsgSendSellOrder) GetTimeInForce() TimeInForce {
 	if m != nil {
-		return m.Price
+		return m.TimeInForce
 	}
-	return 0
+	return GoodTilCancelled
+}
+
+func (m *MsgSendSellOrder) GetExpiry() OrderExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return OrderExpiry{}
+}
+
+func (m *MsgSendSellOrder) GetPostOnly() bool {
+	if m != nil {
+		return m.PostOnly
+	}
+	return false
+}
+
+func (m *MsgSendSellOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return CancelResting
 }
 
 type MsgSendSellOrderResponse struct {
+	// stopOrderID is the ID of the stop order placed, if any
+	StopOrderID uint64 `protobuf:"varint,1,opt,name=stopOrderID,proto3" json:"stopOrderID,omitempty"`
 }
 
 func (m *MsgSendSellOrderResponse) Reset()         { *m = MsgSendSellOrderResponse{} }
@@ -283,15 +327,37 @@ func (m *MsgSendSellOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendSellOrderResponse proto.InternalMessageInfo
 
+func (m *MsgSendSellOrderResponse) GetStopOrderID() uint64 {
+	if m != nil {
+		return m.StopOrderID
+	}
+	return 0
+}
+
 type MsgSendBuyOrder struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Port             string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
-	ChannelID        string `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
-	AmountDenom      string `protobuf:"bytes,5,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
-	Amount           int32  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	PriceDenom       string `protobuf:"bytes,7,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
-	Price            int32  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
+	Creator          string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port             string                                 `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID        string                                 `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	TimeoutTimestamp uint64                                 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	AmountDenom      string                                 `protobuf:"bytes,5,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	PriceDenom       string                                 `protobuf:"bytes,7,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	// price is the limit price of limit orders and the protection price of market orders, zero for market
+	// orders without protection price
+	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	OrderType   OrderType                              `protobuf:"varint,9,opt,name=orderType,proto3,enum=interchangenel.dex.OrderType" json:"orderType,omitempty"`
+	TimeInForce TimeInForce                            `protobuf:"varint,10,opt,name=timeInForce,proto3,enum=interchangenel.dex.TimeInForce" json:"timeInForce,omitempty"`
+	Expiry      OrderExpiry                            `protobuf:"bytes,11,opt,name=expiry,proto3" json:"expiry"`
+	// post-only orders are rejected instead of matching on reception
+	PostOnly bool `protobuf:"varint,12,opt,name=postOnly,proto3" json:"postOnly,omitempty"`
+	// stop orders are escrowed until the last price of the pair crosses their stop price, zero for none
+	StopPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=stopPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stopPrice"`
+	// iceberg orders only show displayAmount on the book, the rest is hidden in reserve, zero for none
+	DisplayAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=displayAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"displayAmount"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,15,opt,name=selfTradePrevention,proto3,enum=interchangenel.dex.SelfTradePrevention" json:"selfTradePrevention,omitempty"`
+	// maxQuote is the quote escrowed and spent at most by market orders without protection price, zero for
+	// the other orders
+	MaxQuote github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=maxQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxQuote"`
 }
 
 func (m *MsgSendBuyOrder) Reset()         { *m = MsgSendBuyOrder{} }
@@ -362,28 +428,51 @@ func (m *MsgSendBuyOrder) GetAmountDenom() string {
 	return ""
 }
 
-func (m *MsgSendBuyOrder) GetAmount() int32 {
+func (m *MsgSendBuyOrder) GetPriceDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.PriceDenom
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgSendBuyOrder) GetPriceDenom() string {
+func (m *MsgSendBuyOrder) GetOrderType() OrderType {
 	if m != nil {
-		return m.PriceDenom
+		return m.OrderType
 	}
-	return ""
+	return LimitOrder
 }
 
-func (m *MsgSendBuyOrder) GetPrice() int32 {
+func (m *MsgSendBuyOrder) GetTimeInForce() TimeInForce {
 	if m != nil {
-		return m.Price
+		return m.TimeInForce
 	}
-	return 0
+	return GoodTilCancelled
+}
+
+func (m *MsgSendBuyOrder) GetExpiry() OrderExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return OrderExpiry{}
+}
+
+func (m *MsgSendBuyOrder) GetPostOnly() bool {
+	if m != nil {
+		return m.PostOnly
+	}
+	return false
+}
+
+func (m *MsgSendBuyOrder) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return CancelResting
 }
 
 type MsgSendBuyOrderResponse struct {
+	// stopOrderID is the ID of the stop order placed, if any
+	StopOrderID uint64 `protobuf:"varint,1,opt,name=stopOrderID,proto3" json:"stopOrderID,omitempty"`
 }
 
 func (m *MsgSendBuyOrderResponse) Reset()         { *m = MsgSendBuyOrderResponse{} }
@@ -419,6 +508,13 @@ func (m *MsgSendBuyOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendBuyOrderResponse proto.InternalMessageInfo
 
+func (m *MsgSendBuyOrderResponse) GetStopOrderID() uint64 {
+	if m != nil {
+		return m.StopOrderID
+	}
+	return 0
+}
+
 type MsgCancelSellOrder struct {
 	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Port        string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
@@ -659,6 +755,235 @@ func (m *MsgCancelBuyOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelBuyOrderResponse proto.InternalMessageInfo
 
+type MsgCancelStopOrder struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelStopOrder) Reset()         { *m = MsgCancelStopOrder{} }
+func (m *MsgCancelStopOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStopOrder) ProtoMessage()    {}
+func (*MsgCancelStopOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{10}
+}
+func (m *MsgCancelStopOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStopOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStopOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelStopOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStopOrder.Merge(m, src)
+}
+func (m *MsgCancelStopOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStopOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStopOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStopOrder proto.InternalMessageInfo
+
+func (m *MsgCancelStopOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelStopOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelStopOrderResponse struct {
+}
+
+func (m *MsgCancelStopOrderResponse) Reset()         { *m = MsgCancelStopOrderResponse{} }
+func (m *MsgCancelStopOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStopOrderResponse) ProtoMessage()    {}
+func (*MsgCancelStopOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{11}
+}
+func (m *MsgCancelStopOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStopOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStopOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelStopOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStopOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelStopOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStopOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStopOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStopOrderResponse proto.InternalMessageInfo
+
+// MsgAmendOrder changes the price or reduces the amount of an order resting on the book of this chain
+type MsgAmendOrder struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port        string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	Channel     string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	AmountDenom string `protobuf:"bytes,4,opt,name=amountDenom,proto3" json:"amountDenom,omitempty"`
+	PriceDenom  string `protobuf:"bytes,5,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	// side of the order: 1 for the buy order book, 2 for the sell order book
+	Side    uint32                                 `protobuf:"varint,6,opt,name=side,proto3" json:"side,omitempty"`
+	OrderID int32                                  `protobuf:"varint,7,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Price   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *MsgAmendOrder) Reset()         { *m = MsgAmendOrder{} }
+func (m *MsgAmendOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendOrder) ProtoMessage()    {}
+func (*MsgAmendOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{12}
+}
+func (m *MsgAmendOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendOrder.Merge(m, src)
+}
+func (m *MsgAmendOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendOrder proto.InternalMessageInfo
+
+func (m *MsgAmendOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAmendOrder) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *MsgAmendOrder) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *MsgAmendOrder) GetAmountDenom() string {
+	if m != nil {
+		return m.AmountDenom
+	}
+	return ""
+}
+
+func (m *MsgAmendOrder) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *MsgAmendOrder) GetSide() uint32 {
+	if m != nil {
+		return m.Side
+	}
+	return 0
+}
+
+func (m *MsgAmendOrder) GetOrderID() int32 {
+	if m != nil {
+		return m.OrderID
+	}
+	return 0
+}
+
+type MsgAmendOrderResponse struct {
+	// ID of the amended order, a new one when its price changed
+	OrderID int32 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+}
+
+func (m *MsgAmendOrderResponse) Reset()         { *m = MsgAmendOrderResponse{} }
+func (m *MsgAmendOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendOrderResponse) ProtoMessage()    {}
+func (*MsgAmendOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{13}
+}
+func (m *MsgAmendOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendOrderResponse.Merge(m, src)
+}
+func (m *MsgAmendOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendOrderResponse proto.InternalMessageInfo
+
+func (m *MsgAmendOrderResponse) GetOrderID() int32 {
+	if m != nil {
+		return m.OrderID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSendCreatePair)(nil), "interchangenel.dex.MsgSendCreatePair")
 	proto.RegisterType((*MsgSendCreatePairResponse)(nil), "interchangenel.dex.MsgSendCreatePairResponse")
@@ -670,44 +995,74 @@ func init() {
 	proto.RegisterType((*MsgCancelSellOrderResponse)(nil), "interchangenel.dex.MsgCancelSellOrderResponse")
 	proto.RegisterType((*MsgCancelBuyOrder)(nil), "interchangenel.dex.MsgCancelBuyOrder")
 	proto.RegisterType((*MsgCancelBuyOrderResponse)(nil), "interchangenel.dex.MsgCancelBuyOrderResponse")
+	proto.RegisterType((*MsgCancelStopOrder)(nil), "interchangenel.dex.MsgCancelStopOrder")
+	proto.RegisterType((*MsgCancelStopOrderResponse)(nil), "interchangenel.dex.MsgCancelStopOrderResponse")
+	proto.RegisterType((*MsgAmendOrder)(nil), "interchangenel.dex.MsgAmendOrder")
+	proto.RegisterType((*MsgAmendOrderResponse)(nil), "interchangenel.dex.MsgAmendOrderResponse")
 }
 
 func init() { proto.RegisterFile("dex/tx.proto", fileDescriptor_463701e671e5a5e0) }
 
 var fileDescriptor_463701e671e5a5e0 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0x52, 0x27, 0xa1, 0x43, 0x69, 0xcb, 0x0a, 0x81, 0xeb, 0x56, 0x56, 0x64, 0x28, 0xaa,
-	0x80, 0x38, 0x12, 0x88, 0x17, 0x68, 0x73, 0xe9, 0x21, 0x02, 0xa5, 0x9c, 0x38, 0x61, 0x9c, 0xa9,
-	0xb1, 0x64, 0xef, 0x5a, 0xeb, 0x8d, 0x94, 0x3e, 0x01, 0x57, 0xde, 0x06, 0x1e, 0x81, 0x1b, 0x3d,
-	0x72, 0x44, 0xc9, 0x53, 0xc0, 0x09, 0x79, 0xfd, 0x83, 0x7f, 0x44, 0x64, 0xf5, 0x92, 0x03, 0xb7,
-	0x7c, 0x33, 0xdf, 0xcc, 0xec, 0xf7, 0x65, 0x77, 0x0c, 0x3b, 0x33, 0x5c, 0x8c, 0xe4, 0xc2, 0x8e,
-	0x04, 0x97, 0x9c, 0x52, 0x9f, 0x49, 0x14, 0xee, 0x47, 0x87, 0x79, 0xc8, 0x30, 0xb0, 0x67, 0xb8,
-	0xb0, 0xbe, 0x13, 0xb8, 0x37, 0x89, 0xbd, 0x0b, 0x64, 0xb3, 0x33, 0x81, 0x8e, 0xc4, 0x37, 0x8e,
-	0x2f, 0xa8, 0x0e, 0x7d, 0x37, 0x41, 0x5c, 0xe8, 0x64, 0x40, 0x4e, 0xb6, 0xa7, 0x39, 0xa4, 0x14,
-	0xb4, 0x88, 0x0b, 0xa9, 0xdf, 0x52, 0x61, 0xf5, 0x9b, 0x1e, 0xc1, 0x76, 0xd2, 0x94, 0x61, 0x70,
-	0x3e, 0xd6, 0xb7, 0x54, 0xe2, 0x6f, 0x80, 0x3e, 0x85, 0x7d, 0xe9, 0x87, 0xc8, 0xe7, 0xf2, 0xad,
-	0x1f, 0x62, 0x2c, 0x9d, 0x30, 0xd2, 0xb5, 0x01, 0x39, 0xd1, 0xa6, 0x8d, 0x38, 0x1d, 0xc0, 0x9d,
-	0x98, 0xcf, 0x85, 0x8b, 0x63, 0x64, 0x3c, 0xd4, 0xbb, 0xaa, 0x57, 0x39, 0x94, 0x30, 0xa4, 0x23,
-	0x3c, 0x94, 0x29, 0xa3, 0x97, 0x32, 0x4a, 0x21, 0xeb, 0x10, 0x0e, 0x1a, 0x82, 0xa6, 0x18, 0x47,
-	0x9c, 0xc5, 0x68, 0xfd, 0x26, 0xb0, 0x9f, 0x65, 0x2f, 0x30, 0x08, 0x5e, 0x8b, 0x19, 0x6e, 0x54,
-	0xad, 0x13, 0xf2, 0x39, 0x93, 0x15, 0xb5, 0xa5, 0x10, 0x7d, 0x00, 0xbd, 0x14, 0x2a, 0xa1, 0xdd,
-	0x69, 0x86, 0xa8, 0x09, 0x10, 0x09, 0x3f, 0xb7, 0xa9, 0xaf, 0x0a, 0x4b, 0x11, 0x7a, 0x1f, 0xba,
-	0x0a, 0xe9, 0xb7, 0x55, 0x59, 0x0a, 0x2c, 0x03, 0xf4, 0xba, 0xf6, 0xc2, 0x98, 0x5f, 0x04, 0xf6,
-	0xb2, 0xe4, 0xe9, 0xfc, 0xea, 0xff, 0xf2, 0xe5, 0x00, 0x1e, 0xd6, 0xa4, 0x17, 0xb6, 0x7c, 0x25,
-	0x40, 0x27, 0xb1, 0x77, 0xe6, 0x30, 0x17, 0x83, 0x9b, 0xde, 0x98, 0x84, 0x9d, 0x1a, 0x91, 0xf9,
-	0x92, 0xc3, 0xba, 0x52, 0xad, 0xa9, 0xb4, 0xaa, 0xa8, 0xdb, 0x50, 0xa4, 0x43, 0x9f, 0x27, 0x47,
-	0x3a, 0x1f, 0x67, 0x56, 0xe4, 0xd0, 0x3a, 0x02, 0xa3, 0x79, 0xf2, 0x42, 0xd8, 0x97, 0xf4, 0xdd,
-	0xa7, 0xe9, 0x1b, 0xfe, 0xe3, 0x9b, 0xd1, 0x95, 0xbe, 0xef, 0xea, 0xc1, 0x73, 0x59, 0x2f, 0x3e,
-	0x69, 0xb0, 0x35, 0x89, 0x3d, 0x7a, 0x09, 0xbb, 0xb5, 0x95, 0x76, 0x6c, 0x37, 0xb7, 0x9f, 0xdd,
-	0x58, 0x14, 0xc6, 0xb0, 0x15, 0x2d, 0x9f, 0x47, 0x5d, 0xb8, 0x5b, 0xdd, 0x25, 0x8f, 0xd7, 0xd4,
-	0x17, 0x2c, 0xe3, 0x79, 0x1b, 0x56, 0x31, 0xe4, 0x3d, 0xec, 0x54, 0xde, 0xe5, 0xa3, 0x35, 0xd5,
-	0x39, 0xc9, 0x78, 0xd6, 0x82, 0x54, 0x4c, 0xf0, 0x61, 0xaf, 0x7e, 0xc5, 0x9f, 0xfc, 0xa3, 0xbe,
-	0xc6, 0x33, 0xec, 0x76, 0xbc, 0x62, 0xd4, 0x25, 0xec, 0xd6, 0x2e, 0xdd, 0xf1, 0xda, 0x0e, 0x85,
-	0xa0, 0x61, 0x2b, 0x5a, 0x3e, 0xe7, 0xf4, 0xd5, 0xb7, 0xa5, 0x49, 0xae, 0x97, 0x26, 0xf9, 0xb9,
-	0x34, 0xc9, 0xe7, 0x95, 0xd9, 0xb9, 0x5e, 0x99, 0x9d, 0x1f, 0x2b, 0xb3, 0xf3, 0xee, 0xb0, 0xd4,
-	0x67, 0xc8, 0x30, 0x18, 0x2d, 0x46, 0xea, 0x33, 0x79, 0x15, 0x61, 0xfc, 0xa1, 0xa7, 0x3e, 0x95,
-	0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x13, 0x6e, 0x5d, 0x77, 0x3a, 0x07, 0x00, 0x00,
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0xb3, 0xf9, 0x7c, 0xdb, 0xa4, 0xdd, 0x61, 0x11, 0xc6, 0xbb, 0xa4, 0x21, 0xb0, 0x4b,
+	0xf8, 0xa8, 0x23, 0x16, 0x71, 0x40, 0x0b, 0x48, 0xed, 0x86, 0x95, 0x8a, 0xa8, 0x5a, 0xdc, 0x5c,
+	0xd8, 0x0b, 0x78, 0xed, 0xb7, 0x5e, 0x0b, 0xdb, 0x63, 0xcd, 0x4c, 0x50, 0xf2, 0x17, 0x38, 0xf1,
+	0x63, 0x90, 0xe0, 0x27, 0xec, 0x09, 0xed, 0x05, 0x09, 0x71, 0x58, 0xa1, 0xf6, 0x87, 0x80, 0x3c,
+	0xfe, 0x88, 0x9d, 0xa4, 0x6d, 0x36, 0x7b, 0x28, 0x87, 0x9e, 0xe2, 0x79, 0xe7, 0x79, 0xde, 0x99,
+	0xf7, 0x19, 0x3f, 0x6f, 0xc6, 0xb0, 0x61, 0xe3, 0xb8, 0x2f, 0xc6, 0x7a, 0xc8, 0xa8, 0xa0, 0x84,
+	0xb8, 0x81, 0x40, 0x66, 0x3d, 0x35, 0x03, 0x07, 0x03, 0xf4, 0x74, 0x1b, 0xc7, 0xda, 0x2d, 0x87,
+	0x3a, 0x54, 0x4e, 0xf7, 0xa3, 0xa7, 0x18, 0xa9, 0x6d, 0x46, 0x3c, 0xca, 0x6c, 0x64, 0x49, 0xe0,
+	0x56, 0x14, 0x08, 0x4d, 0x97, 0x7d, 0xcf, 0x46, 0x1e, 0xf2, 0x38, 0xda, 0xfd, 0xb9, 0x04, 0x37,
+	0x0f, 0xb8, 0x73, 0x8c, 0x81, 0xfd, 0x90, 0xa1, 0x29, 0xf0, 0xc8, 0x74, 0x19, 0x51, 0xa1, 0x66,
+	0x45, 0x23, 0xca, 0x54, 0xa5, 0xa3, 0xf4, 0x1a, 0x46, 0x3a, 0x24, 0x04, 0xca, 0x21, 0x65, 0x42,
+	0x2d, 0xc9, 0xb0, 0x7c, 0x26, 0x77, 0xa0, 0x11, 0xed, 0x28, 0x40, 0x6f, 0x7f, 0xa0, 0xde, 0x90,
+	0x13, 0xd3, 0x00, 0xf9, 0x00, 0xb6, 0x84, 0xeb, 0x23, 0x1d, 0x89, 0xa1, 0xeb, 0x23, 0x17, 0xa6,
+	0x1f, 0xaa, 0xe5, 0x8e, 0xd2, 0x2b, 0x1b, 0x73, 0x71, 0xd2, 0x81, 0x75, 0x4e, 0x47, 0xcc, 0xc2,
+	0x01, 0x06, 0xd4, 0x57, 0x2b, 0x32, 0x57, 0x3e, 0x14, 0x21, 0x84, 0xc9, 0x1c, 0x14, 0x31, 0xa2,
+	0x1a, 0x23, 0x72, 0x21, 0xf2, 0x19, 0x54, 0x64, 0x81, 0x6a, 0xad, 0xa3, 0xf4, 0xd6, 0xef, 0xbf,
+	0xa5, 0xcf, 0x4b, 0xa6, 0x47, 0x45, 0x1a, 0x11, 0x68, 0xaf, 0xfc, 0xec, 0xc5, 0xf6, 0x9a, 0x11,
+	0x33, 0xba, 0xb7, 0xe1, 0xcd, 0x39, 0x2d, 0x0c, 0xe4, 0x21, 0x0d, 0x38, 0x76, 0x7f, 0xad, 0xc2,
+	0x56, 0x32, 0x7b, 0x8c, 0x9e, 0x77, 0x18, 0x49, 0x7b, 0x95, 0x42, 0x99, 0x3e, 0x1d, 0x05, 0xa2,
+	0x20, 0x54, 0x2e, 0x44, 0x1e, 0x41, 0x35, 0x1e, 0xc6, 0x1a, 0xed, 0xe9, 0x51, 0xa1, 0x7f, 0xbf,
+	0xd8, 0xbe, 0xe7, 0xb8, 0xe2, 0xe9, 0xe8, 0x89, 0x6e, 0x51, 0xbf, 0x6f, 0x51, 0xee, 0x53, 0x9e,
+	0xfc, 0xec, 0x70, 0xfb, 0xc7, 0xbe, 0x98, 0x84, 0xc8, 0xf5, 0xfd, 0x40, 0x18, 0x09, 0x9b, 0xb4,
+	0x01, 0x42, 0xe6, 0xa6, 0x27, 0x52, 0x93, 0x0b, 0xe5, 0x22, 0x64, 0x00, 0x15, 0x39, 0x52, 0xeb,
+	0x2f, 0xbd, 0xcc, 0x00, 0x2d, 0x23, 0x26, 0x93, 0x07, 0xd0, 0x90, 0xef, 0xea, 0x70, 0x12, 0xa2,
+	0xda, 0xe8, 0x28, 0xbd, 0xd6, 0xe2, 0x83, 0x3b, 0x4c, 0x41, 0xc6, 0x14, 0x4f, 0x76, 0x61, 0x3d,
+	0x12, 0x68, 0x3f, 0x78, 0x44, 0x99, 0x85, 0x2a, 0x48, 0xfa, 0xf6, 0x22, 0xfa, 0x70, 0x0a, 0x33,
+	0xf2, 0x1c, 0xf2, 0x05, 0x54, 0x71, 0x1c, 0xba, 0x6c, 0xa2, 0xae, 0xcb, 0xb7, 0x66, 0xfb, 0xdc,
+	0xc5, 0xbf, 0x92, 0xb0, 0xe4, 0xbd, 0x49, 0x48, 0x44, 0x83, 0x7a, 0x48, 0xb9, 0x38, 0x0c, 0xbc,
+	0x89, 0xba, 0xd1, 0x51, 0x7a, 0x75, 0x23, 0x1b, 0x93, 0x6f, 0xa0, 0xc1, 0x05, 0x0d, 0x8f, 0xa4,
+	0x48, 0xcd, 0x95, 0x44, 0x9a, 0x26, 0x20, 0x43, 0x68, 0xda, 0x2e, 0x0f, 0x3d, 0x73, 0xb2, 0x1b,
+	0x9f, 0x6e, 0x6b, 0xa5, 0xd3, 0x2d, 0x26, 0x21, 0xdf, 0xc1, 0x6b, 0x1c, 0xbd, 0x93, 0x21, 0x33,
+	0x6d, 0x3c, 0x62, 0xf8, 0x13, 0x06, 0xc2, 0xa5, 0x81, 0xba, 0x29, 0x95, 0x7c, 0x6f, 0x91, 0x16,
+	0xc7, 0xf3, 0x70, 0x63, 0x51, 0x8e, 0xee, 0xe7, 0xa0, 0xce, 0xba, 0x26, 0xb5, 0x94, 0xb4, 0xbb,
+	0xa0, 0xa1, 0x0c, 0xee, 0x0f, 0xa4, 0x83, 0xca, 0x46, 0x3e, 0xd4, 0xfd, 0xb7, 0x0a, 0x9b, 0x09,
+	0x7d, 0x6f, 0x34, 0xb9, 0xf6, 0xdc, 0xb5, 0xe7, 0xae, 0x3d, 0x77, 0xa9, 0xe7, 0xc8, 0xd7, 0x50,
+	0xf7, 0xcd, 0xf1, 0xb7, 0x23, 0x2a, 0x50, 0xdd, 0x5a, 0x69, 0xaf, 0x19, 0xbf, 0xfb, 0x00, 0xde,
+	0x98, 0x31, 0xe0, 0x4b, 0xd8, 0xf7, 0x77, 0x05, 0xc8, 0x01, 0x77, 0x1e, 0x9a, 0x81, 0x85, 0xde,
+	0xaa, 0xff, 0x9a, 0x11, 0x3a, 0x36, 0x6c, 0xe2, 0xdf, 0x74, 0x38, 0xeb, 0xc8, 0xf2, 0xbc, 0x23,
+	0x8b, 0x4e, 0xaa, 0xcc, 0x39, 0x49, 0x85, 0x1a, 0x4d, 0xb6, 0x1f, 0x59, 0xb6, 0x62, 0xa4, 0xc3,
+	0xee, 0x1d, 0xd0, 0xe6, 0x77, 0x9e, 0x5d, 0x06, 0x7e, 0x53, 0xe0, 0x66, 0x36, 0xbd, 0x62, 0x67,
+	0xba, 0x9a, 0xba, 0xe2, 0x3b, 0x4e, 0x71, 0xe3, 0x59, 0x59, 0x5f, 0xe6, 0x8f, 0x2b, 0x3d, 0xc7,
+	0x0b, 0xca, 0x6a, 0x41, 0xc9, 0xb5, 0x65, 0x51, 0x65, 0xa3, 0xe4, 0xda, 0x45, 0xd1, 0x52, 0x7e,
+	0x96, 0xfd, 0xcf, 0x12, 0x34, 0x0f, 0xb8, 0xb3, 0xeb, 0x63, 0x60, 0xff, 0xdf, 0x04, 0x23, 0x50,
+	0xe6, 0xae, 0x8d, 0x52, 0xad, 0xa6, 0x21, 0x9f, 0xf3, 0x22, 0xd6, 0x0a, 0x22, 0xe6, 0x1a, 0x7d,
+	0xfd, 0x95, 0x1a, 0x7d, 0xd6, 0xc8, 0x1b, 0xaf, 0xd0, 0xc8, 0xbb, 0x1f, 0xc3, 0xeb, 0x05, 0x59,
+	0x33, 0x83, 0xe6, 0x0a, 0x50, 0x0a, 0x05, 0xdc, 0xff, 0xa3, 0x02, 0x37, 0x0e, 0xb8, 0x43, 0x4e,
+	0xa0, 0x35, 0x73, 0xf5, 0xbf, 0xbb, 0xa8, 0xf3, 0xcc, 0xdd, 0x8a, 0xb5, 0x9d, 0xa5, 0x60, 0xd9,
+	0x4e, 0x2c, 0x68, 0x16, 0x2f, 0xce, 0xef, 0x5e, 0xc0, 0xcf, 0x50, 0xda, 0x47, 0xcb, 0xa0, 0xb2,
+	0x45, 0x7e, 0x80, 0x8d, 0xc2, 0x45, 0xe1, 0x9d, 0x0b, 0xd8, 0x29, 0x48, 0xfb, 0x70, 0x09, 0x50,
+	0xb6, 0x82, 0x0b, 0x9b, 0xb3, 0xbd, 0xec, 0xde, 0x39, 0xfc, 0x19, 0x9c, 0xa6, 0x2f, 0x87, 0xcb,
+	0x96, 0x3a, 0x81, 0xd6, 0x4c, 0x77, 0xb9, 0x7b, 0x61, 0x86, 0xac, 0xa0, 0x9d, 0xa5, 0x60, 0x0b,
+	0x4a, 0xca, 0xfc, 0x7e, 0x49, 0x49, 0x29, 0x4e, 0xd3, 0x97, 0xc3, 0x65, 0x4b, 0x3d, 0x06, 0xc8,
+	0x79, 0xff, 0xed, 0x73, 0xd8, 0x53, 0x88, 0xf6, 0xfe, 0xa5, 0x90, 0x34, 0xf7, 0xde, 0xa7, 0xcf,
+	0x4e, 0xdb, 0xca, 0xf3, 0xd3, 0xb6, 0xf2, 0xcf, 0x69, 0x5b, 0xf9, 0xe5, 0xac, 0xbd, 0xf6, 0xfc,
+	0xac, 0xbd, 0xf6, 0xd7, 0x59, 0x7b, 0xed, 0xf1, 0xed, 0x5c, 0x8e, 0x9d, 0x00, 0xbd, 0xfe, 0xb8,
+	0x2f, 0x3f, 0xa9, 0x23, 0x17, 0x3d, 0xa9, 0xca, 0xaf, 0xe0, 0x4f, 0xfe, 0x1b, 0x00, 0x6b, 0xa2,
+	0x01, 0x90, 0x66, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -727,6 +1082,8 @@ type MsgClient interface {
 	SendBuyOrder(ctx context.Context, in *MsgSendBuyOrder, opts ...grpc.CallOption) (*MsgSendBuyOrderResponse, error)
 	CancelSellOrder(ctx context.Context, in *MsgCancelSellOrder, opts ...grpc.CallOption) (*MsgCancelSellOrderResponse, error)
 	CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, opts ...grpc.CallOption) (*MsgCancelBuyOrderResponse, error)
+	CancelStopOrder(ctx context.Context, in *MsgCancelStopOrder, opts ...grpc.CallOption) (*MsgCancelStopOrderResponse, error)
+	AmendOrder(ctx context.Context, in *MsgAmendOrder, opts ...grpc.CallOption) (*MsgAmendOrderResponse, error)
 }
 
 type msgClient struct {
@@ -782,6 +1139,24 @@ func (c *msgClient) CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, o
 	return out, nil
 }
 
+func (c *msgClient) CancelStopOrder(ctx context.Context, in *MsgCancelStopOrder, opts ...grpc.CallOption) (*MsgCancelStopOrderResponse, error) {
+	out := new(MsgCancelStopOrderResponse)
+	err := c.cc.Invoke(ctx, "/interchangenel.dex.Msg/CancelStopOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendOrder(ctx context.Context, in *MsgAmendOrder, opts ...grpc.CallOption) (*MsgAmendOrderResponse, error) {
+	out := new(MsgAmendOrderResponse)
+	err := c.cc.Invoke(ctx, "/interchangenel.dex.Msg/AmendOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SendCreatePair(context.Context, *MsgSendCreatePair) (*MsgSendCreatePairResponse, error)
@@ -789,6 +1164,8 @@ type MsgServer interface {
 	SendBuyOrder(context.Context, *MsgSendBuyOrder) (*MsgSendBuyOrderResponse, error)
 	CancelSellOrder(context.Context, *MsgCancelSellOrder) (*MsgCancelSellOrderResponse, error)
 	CancelBuyOrder(context.Context, *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error)
+	CancelStopOrder(context.Context, *MsgCancelStopOrder) (*MsgCancelStopOrderResponse, error)
+	AmendOrder(context.Context, *MsgAmendOrder) (*MsgAmendOrderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -810,6 +1187,12 @@ func (*UnimplementedMsgServer) CancelSellOrder(ctx context.Context, req *MsgCanc
 func (*UnimplementedMsgServer) CancelBuyOrder(ctx context.Context, req *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBuyOrder not implemented")
 }
+func (*UnimplementedMsgServer) CancelStopOrder(ctx context.Context, req *MsgCancelStopOrder) (*MsgCancelStopOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStopOrder not implemented")
+}
+func (*UnimplementedMsgServer) AmendOrder(ctx context.Context, req *MsgAmendOrder) (*MsgAmendOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendOrder not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -905,6 +1288,42 @@ func _Msg_CancelBuyOrder_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelStopOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStopOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStopOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchangenel.dex.Msg/CancelStopOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStopOrder(ctx, req.(*MsgCancelStopOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchangenel.dex.Msg/AmendOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendOrder(ctx, req.(*MsgAmendOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "interchangenel.dex.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -929,6 +1348,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBuyOrder",
 			Handler:    _Msg_CancelBuyOrder_Handler,
 		},
+		{
+			MethodName: "CancelStopOrder",
+			Handler:    _Msg_CancelStopOrder_Handler,
+		},
+		{
+			MethodName: "AmendOrder",
+			Handler:    _Msg_AmendOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dex/tx.proto",
@@ -954,6 +1381,16 @@ func (m *MsgSendCreatePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.TargetDenom) > 0 {
 		i -= len(m.TargetDenom)
 		copy(dAtA[i:], m.TargetDenom)
@@ -1040,23 +1477,88 @@ func (m *MsgSendSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x78
 	}
-	if len(m.PriceDenom) > 0 {
-		i -= len(m.PriceDenom)
-		copy(dAtA[i:], m.PriceDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceDenom)))
-		i--
-		dAtA[i] = 0x3a
+	{
+		size := m.DisplayAmount.Size()
+		i -= size
+		if _, err := m.DisplayAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.StopPrice.Size()
+		i -= size
+		if _, err := m.StopPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+	i--
+	dAtA[i] = 0x6a
+	if m.PostOnly {
 		i--
-		dAtA[i] = 0x30
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.AmountDenom) > 0 {
 		i -= len(m.AmountDenom)
 		copy(dAtA[i:], m.AmountDenom)
@@ -1113,6 +1615,11 @@ func (m *MsgSendSellOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.StopOrderID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StopOrderID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1136,11 +1643,83 @@ func (m *MsgSendBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
+	{
+		size := m.MaxQuote.Size()
+		i -= size
+		if _, err := m.MaxQuote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.DisplayAmount.Size()
+		i -= size
+		if _, err := m.DisplayAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.StopPrice.Size()
+		i -= size
+		if _, err := m.StopPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x50
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.PriceDenom) > 0 {
 		i -= len(m.PriceDenom)
 		copy(dAtA[i:], m.PriceDenom)
@@ -1148,11 +1727,16 @@ func (m *MsgSendBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x30
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.AmountDenom) > 0 {
 		i -= len(m.AmountDenom)
 		copy(dAtA[i:], m.AmountDenom)
@@ -1209,6 +1793,11 @@ func (m *MsgSendBuyOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.StopOrderID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StopOrderID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1384,6 +1973,180 @@ func (m *MsgCancelBuyOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelStopOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStopOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStopOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStopOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStopOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStopOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.OrderID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AmountDenom) > 0 {
+		i -= len(m.AmountDenom)
+		copy(dAtA[i:], m.AmountDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1424,6 +2187,8 @@ func (m *MsgSendCreatePair) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.Rules.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1461,15 +2226,31 @@ func (m *MsgSendSellOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = len(m.PriceDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = m.Expiry.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PostOnly {
+		n += 2
+	}
+	l = m.StopPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DisplayAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
 	}
 	return n
 }
@@ -1480,6 +2261,9 @@ func (m *MsgSendSellOrderResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.StopOrderID != 0 {
+		n += 1 + sovTx(uint64(m.StopOrderID))
+	}
 	return n
 }
 
@@ -1508,16 +2292,34 @@ func (m *MsgSendBuyOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = len(m.PriceDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	l = m.Expiry.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PostOnly {
+		n += 2
+	}
+	l = m.StopPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DisplayAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovTx(uint64(m.SelfTradePrevention))
 	}
+	l = m.MaxQuote.Size()
+	n += 2 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1527,6 +2329,9 @@ func (m *MsgSendBuyOrderResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.StopOrderID != 0 {
+		n += 1 + sovTx(uint64(m.StopOrderID))
+	}
 	return n
 }
 
@@ -1612,53 +2417,1166 @@ func (m *MsgCancelBuyOrderResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCancelStopOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCancelStopOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendCreatePair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendCreatePair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+
+func (m *MsgAmendOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AmountDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	if m.OrderID != 0 {
+		n += 1 + sovTx(uint64(m.OrderID))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAmendOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderID != 0 {
+		n += 1 + sovTx(uint64(m.OrderID))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendCreatePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendCreatePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendCreatePairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendCreatePairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendCreatePairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendSellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnly = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisplayAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendSellOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendSellOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendSellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopOrderID", wireType)
+			}
+			m.StopOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendBuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
 				stringLen |= uint64(b&0x7F) << shift
@@ -1677,11 +3595,11 @@ func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1709,13 +3627,53 @@ func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1725,29 +3683,30 @@ func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1757,14 +3716,15 @@ func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.PostOnly = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1792,11 +3752,13 @@ func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.StopPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1824,7 +3786,62 @@ func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.DisplayAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1847,7 +3864,7 @@ func (m *MsgSendCreatePair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendCreatePairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendBuyOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1870,12 +3887,31 @@ func (m *MsgSendCreatePairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendCreatePairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendBuyOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendCreatePairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopOrderID", wireType)
+			}
+			m.StopOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1897,7 +3933,7 @@ func (m *MsgSendCreatePairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSellOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,10 +3956,10 @@ func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendSellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSellOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1992,7 +4028,7 @@ func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2020,28 +4056,9 @@ func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
-			}
-			m.TimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
 			}
@@ -2073,26 +4090,7 @@ func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.AmountDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
@@ -2124,11 +4122,11 @@ func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
 			}
-			m.Price = 0
+			m.OrderID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2138,7 +4136,7 @@ func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= int32(b&0x7F) << shift
+				m.OrderID |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2164,7 +4162,7 @@ func (m *MsgSendSellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendSellOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSellOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,10 +4185,10 @@ func (m *MsgSendSellOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendSellOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSellOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendSellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2214,7 +4212,7 @@ func (m *MsgSendSellOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelBuyOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2237,10 +4235,10 @@ func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendBuyOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelBuyOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2309,7 +4307,7 @@ func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2337,28 +4335,9 @@ func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
-			}
-			m.TimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
 			}
@@ -2390,26 +4369,7 @@ func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.AmountDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
@@ -2441,11 +4401,11 @@ func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
 			}
-			m.Price = 0
+			m.OrderID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2455,7 +4415,7 @@ func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= int32(b&0x7F) << shift
+				m.OrderID |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2481,7 +4441,7 @@ func (m *MsgSendBuyOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendBuyOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelBuyOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,10 +4464,10 @@ func (m *MsgSendBuyOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendBuyOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelBuyOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2531,7 +4491,7 @@ func (m *MsgSendBuyOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSellOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelStopOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2554,10 +4514,10 @@ func (m *MsgCancelSellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelStopOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelStopOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2593,138 +4553,10 @@ func (m *MsgCancelSellOrder) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Port = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AmountDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PriceDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.OrderID = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2734,7 +4566,7 @@ func (m *MsgCancelSellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderID |= int32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2760,7 +4592,7 @@ func (m *MsgCancelSellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSellOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelStopOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2783,10 +4615,10 @@ func (m *MsgCancelSellOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSellOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelStopOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelStopOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2810,7 +4642,7 @@ func (m *MsgCancelSellOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelBuyOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2833,10 +4665,10 @@ func (m *MsgCancelBuyOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelBuyOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3000,6 +4832,25 @@ func (m *MsgCancelBuyOrder) Unmarshal(dAtA []byte) error {
 			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
 			}
@@ -3018,6 +4869,74 @@ func (m *MsgCancelBuyOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3039,7 +4958,7 @@ func (m *MsgCancelBuyOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelBuyOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3062,12 +4981,31 @@ func (m *MsgCancelBuyOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelBuyOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			m.OrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])